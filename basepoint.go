@@ -0,0 +1,130 @@
+package secp256k1
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+)
+
+//go:generate go run genbasepoint.go
+
+// The multiples of G used by BaseScalarMult are precomputed and stored in
+// basepoint_table.go. The scalar is split into windows of baseTableWindow
+// bits and window i holds j * 2^(baseTableWindow*i) * G for every non-zero
+// digit j, so k*G is the sum of one table entry per window and no doublings
+// are needed.
+//
+// The default table uses 4-bit windows (64 windows of 15 points, ~60KB).
+// Building with the secp256k1_smalltable tag uses 2-bit windows instead
+// (128 windows of 3 points, ~24KB) at the cost of twice as many additions.
+
+const baseTableWindows = 256 / baseTableWindow
+
+var (
+	baseTable     [][]Point
+	baseTableOnce sync.Once
+)
+
+// basePointTable decodes the table the first time it is needed
+func basePointTable() [][]Point {
+	baseTableOnce.Do(func() {
+		data, err := hex.DecodeString(baseTableData)
+		if err != nil {
+			panic("secp256k1: invalid base point table: " + err.Error())
+		}
+
+		entries := 1<<baseTableWindow - 1
+		baseTable = make([][]Point, baseTableWindows)
+		for i := range baseTable {
+			baseTable[i] = make([]Point, entries)
+			for j := range baseTable[i] {
+				offset := (i*entries + j) * 64
+				var x, y [32]byte
+				copy(x[:], data[offset:offset+32])
+				copy(y[:], data[offset+32:offset+64])
+
+				p := &baseTable[i][j]
+				p.X.SetBytes(&x)
+				p.Y.SetBytes(&y)
+			}
+		}
+	})
+	return baseTable
+}
+
+// baseDigit returns the i-th window of k
+func baseDigit(k *Scalar, i int) int {
+	bit := i * baseTableWindow
+	return int((k.n[bit/64] >> (bit % 64)) & (1<<baseTableWindow - 1))
+}
+
+// BaseScalarMult computes k*G in constant time. Every window does one
+// table lookup that reads all the entries and one complete addition, a
+// zero digit selects the point at infinity.
+func BaseScalarMult(k *Scalar) *Point {
+	var r ProjectivePoint
+	return r.baseScalarMult(k).ToAffine()
+}
+
+// BatchBaseScalarMult computes the public keys for many secret scalars.
+// Each one is computed like BaseScalarMult but they are all converted to
+// affine with NormalizeBatch, so there is a single field inversion for
+// the whole batch instead of one per key.
+func BatchBaseScalarMult(scalars []*Scalar) []*PublicKey {
+	points := make([]*ProjectivePoint, len(scalars))
+	for i, k := range scalars {
+		points[i] = new(ProjectivePoint).baseScalarMult(k)
+	}
+
+	affine := NormalizeBatch(points)
+	keys := make([]*PublicKey, len(affine))
+	for i := range affine {
+		keys[i] = &PublicKey{Point: affine[i]}
+	}
+	return keys
+}
+
+// baseScalarMult sets p to k*G in projective coordinates
+func (p *ProjectivePoint) baseScalarMult(k *Scalar) *ProjectivePoint {
+	table := basePointTable()
+
+	var t ProjectivePoint
+	p.SetInfinity()
+	for i := 0; i < baseTableWindows; i++ {
+		t.lookupAffine(table[i], baseDigit(k, i))
+		p.Add(p, &t)
+	}
+	return p
+}
+
+// k*G for public scalars. Uses the same table as BaseScalarMult
+// but skips zero digits and uses mixed additions
+func BaseScalarMultVartime(k *Scalar) *Point {
+	table := basePointTable()
+
+	r := new(JacobianPoint).SetInfinity()
+	for i := 0; i < baseTableWindows; i++ {
+		if d := baseDigit(k, i); d != 0 {
+			r.AddMixed(r, &table[i][d-1])
+		}
+	}
+
+	return r.ToAffine()
+}
+
+// lookupAffine sets p to entries[d-1], or the point at infinity if
+// d is 0, in constant time
+func (p *ProjectivePoint) lookupAffine(entries []Point, d int) {
+	p.X.SetInt(0)
+	p.Y.SetInt(1)
+	p.Z.SetInt(1)
+	for j := range entries {
+		flag := subtle.ConstantTimeEq(int32(j+1), int32(d))
+		p.X.CMove(&entries[j].X, flag)
+		p.Y.CMove(&entries[j].Y, flag)
+	}
+
+	// (0:1:0) is the point at infinity
+	var zero FieldElement
+	p.Z.CMove(&zero, subtle.ConstantTimeEq(int32(d), 0))
+}
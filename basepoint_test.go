@@ -0,0 +1,63 @@
+package secp256k1
+
+import (
+	"testing"
+)
+
+func TestBaseScalarMultTable(t *testing.T) {
+	for _, kInt := range scalarTestValues(t) {
+		k, _ := NewScalar(kInt)
+		expected := ScalarMultVartime(k, Curve.G)
+
+		if !BaseScalarMult(k).Equal(expected) {
+			t.Fatalf("BaseScalarMult does not match for k = %x", kInt)
+		}
+		if !BaseScalarMultVartime(k).Equal(expected) {
+			t.Fatalf("BaseScalarMultVartime does not match for k = %x", kInt)
+		}
+	}
+}
+
+func TestBaseTableEntries(t *testing.T) {
+	table := basePointTable()
+	if len(table) != baseTableWindows {
+		t.Fatalf("expected %v windows but got %v", baseTableWindows, len(table))
+	}
+
+	for i := range table {
+		for j := range table[i] {
+			// entry j of window i is (j+1) * 2^(w*i) * G
+			k := new(Scalar).SetInt(uint64(j + 1))
+			shift := new(Scalar).SetInt(1 << baseTableWindow)
+			for w := 0; w < i; w++ {
+				k.Mul(k, shift)
+			}
+
+			if !table[i][j].Equal(ScalarMultVartime(k, Curve.G)) {
+				t.Fatalf("wrong table entry %v in window %v", j, i)
+			}
+		}
+	}
+}
+
+func TestBatchBaseScalarMult(t *testing.T) {
+	values := scalarTestValues(t)
+	scalars := make([]*Scalar, len(values))
+	for i, v := range values {
+		scalars[i], _ = NewScalar(v)
+	}
+
+	keys := BatchBaseScalarMult(scalars)
+	if len(keys) != len(scalars) {
+		t.Fatalf("expected %v keys but got %v", len(scalars), len(keys))
+	}
+	for i, k := range scalars {
+		if !keys[i].Equal(BaseScalarMult(k)) {
+			t.Fatalf("public key does not match for k = %x", values[i])
+		}
+	}
+
+	if keys := BatchBaseScalarMult(nil); len(keys) != 0 {
+		t.Fatalf("expected no keys but got %v", len(keys))
+	}
+}
@@ -2,213 +2,131 @@ package secp256k1
 
 import (
 	"crypto/rand"
-	"fmt"
-	"math/big"
+	"errors"
+	"io"
 )
 
-type FieldElement struct {
-	Value *big.Int
-}
-
-func NewFieldElement(n *big.Int) *FieldElement {
-	value := new(big.Int).Set(n)
-	return &FieldElement{Value: value}
-}
-
-func (fe *FieldElement) Add(x, y *FieldElement) *FieldElement {
-	z := new(big.Int)
-	z.Add(x.Value, y.Value)
-	z.Mod(z, Curve.P)
-	fe.Value = z
-	return fe
-}
-
-func (fe *FieldElement) Sub(x, y *FieldElement) *FieldElement {
-	z := new(big.Int)
-	z.Sub(x.Value, y.Value)
-	z.Mod(z, Curve.P)
-	fe.Value = z
-	return fe
-}
-
-func (fe *FieldElement) Mult(x, y *FieldElement) *FieldElement {
-	z := new(big.Int)
-	z.Mul(x.Value, y.Value)
-	z.Mod(z, Curve.P)
-	fe.Value = z
-	return fe
-}
-
-// Division is done in terms of the multiplicative inverse
-// a / b (mod p) == a (b^-1) (mod p)
-func (fe *FieldElement) Div(x, y *FieldElement) *FieldElement {
-	z := new(big.Int)
-	inverse := new(big.Int)
-	// multiplicative inverse
-	inverse.ModInverse(y.Value, Curve.P)
-	z.Mul(x.Value, inverse)
-	z.Mod(z, Curve.P)
-	fe.Value = z
-	return fe
-}
-
-func (fe *FieldElement) Pow(x *FieldElement, exp *big.Int) *FieldElement {
-	z := new(big.Int)
-	z.Exp(x.Value, exp, Curve.P)
-	fe.Value = z
-	return fe
-}
+var (
+	ErrPointNotOnCurve = errors.New("point is not on the curve")
+	ErrPointAtInfinity = errors.New("point is the point at infinity")
+	ErrBadRandomness   = errors.New("random source did not produce a valid scalar")
+)
 
-func (fe *FieldElement) Equal(e *FieldElement) bool {
-	return fe.Value.Cmp(e.Value) == 0
-}
+// a uniformly random 32-byte value is out of range with probability about
+// 2^-128, failing this many times in a row means the source is broken
+const maxKeyGenerationTries = 64
 
+// Point is a point on the curve in affine coordinates. It is a value type:
+// the coordinates are stored in the point itself, always fully reduced, and
+// no method keeps a reference to its arguments, so points can be copied
+// with = and the receiver of every method may alias any of the arguments.
+// The point at infinity has InfinityPoint set and zero coordinates.
 type Point struct {
-	X             *FieldElement
-	Y             *FieldElement
+	X             FieldElement
+	Y             FieldElement
 	InfinityPoint bool
 }
 
-func (p *Point) Copy() *Point {
-	return &Point{
-		X:             NewFieldElement(p.X.Value),
-		Y:             NewFieldElement(p.Y.Value),
-		InfinityPoint: p.InfinityPoint,
+// NewPoint returns the point (x, y) or ErrPointNotOnCurve
+// if it does not satisfy the curve equation
+func NewPoint(x, y *FieldElement) (*Point, error) {
+	p := &Point{X: *x, Y: *y, InfinityPoint: false}
+	if !p.IsOnCurve() {
+		return nil, ErrPointNotOnCurve
 	}
+	return p, nil
 }
 
-func (p *Point) Add(p1 *Point, p2 *Point) *Point {
-	if p1.InfinityPoint {
-		p.X = p2.X
-		p.Y = p2.Y
-		p.InfinityPoint = p2.InfinityPoint
-		return p
-	}
-	if p2.InfinityPoint {
-		p.X = p1.X
-		p.Y = p1.Y
-		p.InfinityPoint = p1.InfinityPoint
-		return p
+// IsOnCurve reports whether y^2 = x^3 + 7. The point at infinity
+// has no coordinates so it is not considered to be on the curve
+func (p *Point) IsOnCurve() bool {
+	if p.InfinityPoint {
+		return false
 	}
 
-	if p1.X.Equal(p2.X) {
-		// adding a point to itself
-		if p1.Y.Equal(p2.Y) {
-			// s = (3x1^2 + a) / 2y1
-			s := new(FieldElement)
-			fe3 := &FieldElement{Value: big.NewInt(3)}
-			// ignoring a since it is 0
-			s.Pow(p1.X, big.NewInt(2)).Mult(s, fe3)
-
-			fe2 := &FieldElement{Value: big.NewInt(2)}
-			s.Div(s, fe2.Mult(fe2, p1.Y))
-
-			// x3 = s^2 - 2x1
-			x3 := new(FieldElement)
-			fe2 = &FieldElement{Value: big.NewInt(2)}
-			x3.Pow(s, big.NewInt(2))
-			x3.Sub(x3, fe2.Mult(fe2, p1.X))
-
-			// y3 = s(x1 - x3) - y1
-			y3 := new(FieldElement)
-			y3.Sub(p1.X, x3).Mult(y3, s).Sub(y3, p1.Y)
-
-			p.X = x3
-			p.Y = y3
-			p.InfinityPoint = false
-			return p
-		}
+	var lhs, rhs FieldElement
+	lhs.Square(&p.Y)
+	rhs.Square(&p.X).Mult(&rhs, &p.X).Add(&rhs, Curve.B)
+	return lhs.Equal(&rhs)
+}
 
-		// same x coordinate but one y is neg
-		if p1.Y.Value.CmpAbs(p2.Y.Value) == 0 {
-			p.X = nil
-			p.Y = nil
-			p.InfinityPoint = true
-			return p
-		}
+// Validate checks that p can be used as a public key: it must be
+// on the curve and not the point at infinity
+func (p *Point) Validate() error {
+	if p.InfinityPoint {
+		return ErrPointAtInfinity
 	}
+	if !p.IsOnCurve() {
+		return ErrPointNotOnCurve
+	}
+	return nil
+}
 
-	// slope = (y2 - y1) / (x2 - x1)
-	y := new(FieldElement)
-	x := new(FieldElement)
-
-	y.Sub(p2.Y, p1.Y)
-	x.Sub(p2.X, p1.X)
-
-	slope := new(FieldElement)
-	slope.Div(y, x)
-
-	// x3 = slope^2 - x1 - x2
-	x3 := new(FieldElement)
-	x3.Pow(slope, big.NewInt(2)).Sub(x3, p1.X).Sub(x3, p2.X)
-
-	// y3 = slope(x1 - x3) - y1
-	y3 := new(FieldElement)
-	y3.Sub(p1.X, x3).Mult(y3, slope).Sub(y3, p1.Y)
+func (p *Point) setInfinity() *Point {
+	*p = Point{InfinityPoint: true}
+	return p
+}
 
-	p.X = x3
-	p.Y = y3
-	p.InfinityPoint = false
+// Set sets p to q
+func (p *Point) Set(q *Point) *Point {
+	*p = *q
 	return p
 }
 
-func (p *Point) Inverse() *Point {
-	yNeg := new(big.Int).Set(p.Y.Value)
-	yNeg.Neg(yNeg)
-	return &Point{
-		X:             NewFieldElement(p.X.Value),
-		Y:             NewFieldElement(yNeg),
-		InfinityPoint: p.InfinityPoint,
+// Equal reports whether p and q are the same point
+func (p *Point) Equal(q *Point) bool {
+	if p.InfinityPoint || q.InfinityPoint {
+		return p.InfinityPoint == q.InfinityPoint
 	}
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
 }
 
-// big integer modulo n
-type Scalar struct {
-	N *big.Int
+func (p *Point) Copy() *Point {
+	return new(Point).Set(p)
 }
 
-func NewScalar(number *big.Int) (*Scalar, error) {
-	if number.Cmp(Curve.N) > 0 {
-		return nil, fmt.Errorf("scalar needs to be less than n")
-	}
-
-	return &Scalar{N: number}, nil
+// Add sets p to p1 + p2. It uses the complete addition formulas in
+// projective coordinates so the point at infinity, doubling and adding a
+// point to its negation need no special cases and it runs in constant time
+// except for the conversion back to affine.
+func (p *Point) Add(p1 *Point, p2 *Point) *Point {
+	var q1, q2 ProjectivePoint
+	q1.SetAffine(p1)
+	q2.SetAffine(p2)
+	return p.Set(q1.Add(&q1, &q2).ToAffine())
 }
 
-// does double-and-add algorithm
-// k*G
-func BaseScalarMult(k *Scalar) *Point {
-	q := Curve.G.Copy()
-	r := &Point{InfinityPoint: true}
-
-	kInt := new(big.Int).Set(k.N)
-
-	for kInt.Sign() > 0 {
-		if new(big.Int).And(kInt, big.NewInt(1)).Bit(0) == 1 {
-			r.Add(r, q)
-		}
-		q.Add(q, q)
-		kInt.Rsh(kInt, 1)
-	}
-
-	return r
+// Sub sets p to p1 - p2
+func (p *Point) Sub(p1 *Point, p2 *Point) *Point {
+	var neg Point
+	neg.Neg(p2)
+	return p.Add(p1, &neg)
 }
 
-func ScalarMult(k *Scalar, p *Point) *Point {
-	q := p.Copy()
-	r := &Point{InfinityPoint: true}
-
-	kInt := new(big.Int).Set(k.N)
+// Double sets p to 2q
+func (p *Point) Double(q *Point) *Point {
+	var r ProjectivePoint
+	r.SetAffine(q)
+	return p.Set(r.Double(&r).ToAffine())
+}
 
-	for kInt.Sign() > 0 {
-		if new(big.Int).And(kInt, big.NewInt(1)).Bit(0) == 1 {
-			r.Add(r, q)
-		}
-		q.Add(q, q)
-		kInt.Rsh(kInt, 1)
+// Neg sets p to -q = (x, -y). The negation of the point
+// at infinity is itself
+func (p *Point) Neg(q *Point) *Point {
+	if q.InfinityPoint {
+		return p.setInfinity()
 	}
+	p.X.Set(&q.X)
+	p.Y.Negate(&q.Y)
+	p.InfinityPoint = false
+	return p
+}
 
-	return r
+// Inverse returns -p as a new point.
+//
+// Deprecated: use Neg.
+func (p *Point) Inverse() *Point {
+	return new(Point).Neg(p)
 }
 
 type PrivateKey struct {
@@ -216,27 +134,64 @@ type PrivateKey struct {
 	PublicKey *PublicKey
 }
 
-func NewPrivateKey(scalar *Scalar) *PrivateKey {
+// NewPrivateKey returns the private key for scalar and computes its
+// public key. The scalar is copied and must not be zero
+func NewPrivateKey(scalar *Scalar) (*PrivateKey, error) {
+	if scalar.IsZero() {
+		return nil, ErrPrivKeyZero
+	}
+	return newPrivateKey(new(Scalar).Set(scalar)), nil
+}
+
+func newPrivateKey(scalar *Scalar) *PrivateKey {
 	point := BaseScalarMult(scalar)
 	publicKey := &PublicKey{point}
 	return &PrivateKey{SecretKey: scalar, PublicKey: publicKey}
 }
 
+// GeneratePrivateKey generates a random private key using crypto/rand
 func GeneratePrivateKey() (*PrivateKey, error) {
-	random, err := rand.Int(rand.Reader, Curve.N)
+	return GeneratePrivateKeyFrom(rand.Reader)
+}
+
+// GeneratePrivateKeyFrom generates a private key with randomness read
+// from r using GenerateScalarFrom
+func GeneratePrivateKeyFrom(r io.Reader) (*PrivateKey, error) {
+	scalar, err := GenerateScalarFrom(r)
 	if err != nil {
 		return nil, err
 	}
-	scalar, err := NewScalar(random)
-	if err != nil {
-		return nil, err
+	return newPrivateKey(scalar), nil
+}
+
+// GenerateScalarFrom returns a random scalar in [1, n-1] read from r. It
+// reads 32 bytes at a time and rejects values that are zero or not less
+// than n, reading again until it gets a valid one. If r keeps producing
+// invalid values it gives up and returns ErrBadRandomness.
+func GenerateScalarFrom(r io.Reader) (*Scalar, error) {
+	var b [32]byte
+	defer clear(b[:])
+
+	for i := 0; i < maxKeyGenerationTries; i++ {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, err
+		}
+
+		scalar := new(Scalar)
+		if overflow := scalar.SetBytes(&b); overflow || scalar.IsZero() {
+			scalar.Zero()
+			continue
+		}
+		return scalar, nil
 	}
-	k := NewPrivateKey(scalar)
-	return k, nil
+	return nil, ErrBadRandomness
 }
 
 func (pk *PrivateKey) Copy() *PrivateKey {
-	return NewPrivateKey(pk.SecretKey)
+	return &PrivateKey{
+		SecretKey: new(Scalar).Set(pk.SecretKey),
+		PublicKey: &PublicKey{Point: pk.PublicKey.Point.Copy()},
+	}
 }
 
 type PublicKey struct {
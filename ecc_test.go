@@ -0,0 +1,160 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+)
+
+func TestNewPoint(t *testing.T) {
+	p, err := NewPoint(&Curve.G.X, &Curve.G.Y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !p.IsOnCurve() || !p.Equal(Curve.G) {
+		t.Fatal("expected G")
+	}
+
+	// y + 1 is not on the curve
+	y := new(FieldElement).Add(&Curve.G.Y, new(FieldElement).SetInt(1))
+	if _, err := NewPoint(&Curve.G.X, y); !errors.Is(err, ErrPointNotOnCurve) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPointNotOnCurve, err)
+	}
+
+	// x = 0 has no y since 7 is not a square mod p
+	zero := new(FieldElement)
+	seven := NewFieldElement(big.NewInt(7))
+	if _, err := NewPoint(zero, seven); !errors.Is(err, ErrPointNotOnCurve) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPointNotOnCurve, err)
+	}
+}
+
+func TestPointValidate(t *testing.T) {
+	tests := []struct {
+		point *Point
+		err   error
+	}{
+		{Curve.G, nil},
+		{new(Point).Neg(Curve.G), nil},
+		{&Point{InfinityPoint: true}, ErrPointAtInfinity},
+		{&Point{X: Curve.G.Y, Y: Curve.G.X}, ErrPointNotOnCurve},
+	}
+
+	for _, test := range tests {
+		if err := test.point.Validate(); !errors.Is(err, test.err) {
+			t.Fatalf("expected '%v' but got '%v'", test.err, err)
+		}
+	}
+}
+
+func TestPointOperations(t *testing.T) {
+	g := Curve.G
+	g2 := ScalarMultVartime(new(Scalar).SetInt(2), g)
+	g3 := ScalarMultVartime(new(Scalar).SetInt(3), g)
+	infinity := &Point{InfinityPoint: true}
+
+	negG := new(Point).Neg(g)
+	if !new(FieldElement).Add(&negG.Y, &g.Y).IsZero() || !negG.X.Equal(&g.X) {
+		t.Fatal("expected (x, -y)")
+	}
+
+	tests := []struct {
+		name     string
+		got      *Point
+		expected *Point
+	}{
+		{"double", new(Point).Double(g), g2},
+		{"double infinity", new(Point).Double(infinity), infinity},
+		{"sub", new(Point).Sub(g3, g), g2},
+		{"sub itself", new(Point).Sub(g, g), infinity},
+		{"sub infinity", new(Point).Sub(g, infinity), g},
+		{"neg neg", new(Point).Neg(negG), g},
+		{"neg infinity", new(Point).Neg(infinity), infinity},
+		{"inverse", g.Inverse(), negG},
+	}
+
+	for _, test := range tests {
+		if !test.got.Equal(test.expected) {
+			t.Fatalf("%v: points do not match", test.name)
+		}
+	}
+
+	if g.Equal(negG) || g.Equal(infinity) || infinity.Equal(g) {
+		t.Fatal("expected points to be different")
+	}
+}
+
+func TestPointAliasing(t *testing.T) {
+	g := *Curve.G
+	g2 := ScalarMultVartime(new(Scalar).SetInt(2), &g)
+	g3 := ScalarMultVartime(new(Scalar).SetInt(3), &g)
+
+	// the receiver can be one of the arguments
+	p := g
+	p.Add(&p, &p)
+	if !p.Equal(g2) {
+		t.Fatal("aliased add does not match")
+	}
+	p.Sub(&p, &p)
+	if !p.InfinityPoint || !p.X.IsZero() || !p.Y.IsZero() {
+		t.Fatal("expected normalized point at infinity")
+	}
+	p = g
+	p.Double(&p)
+	p.Add(&p, &g)
+	if !p.Equal(g3) {
+		t.Fatal("aliased double does not match")
+	}
+
+	// results never share memory with the inputs
+	q := new(Point).Add(&g, &Point{InfinityPoint: true})
+	q.X.SetInt(1)
+	c := g.Copy()
+	c.Y.SetInt(1)
+	if !g.Equal(Curve.G) {
+		t.Fatal("modifying a result changed its input")
+	}
+}
+
+// zeroReader returns an endless stream of zero bytes
+type zeroReader struct{}
+
+func (zeroReader) Read(b []byte) (int, error) {
+	clear(b)
+	return len(b), nil
+}
+
+func TestGeneratePrivateKeyFrom(t *testing.T) {
+	// zero and values >= n are rejected and more bytes are read
+	nBytes := Curve.N.FillBytes(make([]byte, 32))
+	valid := bytes.Repeat([]byte{0x42}, 32)
+	input := bytes.Join([][]byte{make([]byte, 32), nBytes, bytes.Repeat([]byte{0xff}, 32), valid}, nil)
+
+	key, err := GeneratePrivateKeyFrom(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := key.SecretKey.Bytes(); !bytes.Equal(got[:], valid) {
+		t.Fatalf("expected '%x' but got '%x'", valid, got)
+	}
+	if !key.PublicKey.Equal(BaseScalarMult(key.SecretKey)) {
+		t.Fatal("public key does not match")
+	}
+
+	scalar, err := GenerateScalarFrom(bytes.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !scalar.Equal(key.SecretKey) {
+		t.Fatalf("expected '%x' but got '%x'", valid, scalar.Bytes())
+	}
+
+	if _, err := GeneratePrivateKeyFrom(zeroReader{}); !errors.Is(err, ErrBadRandomness) {
+		t.Fatalf("expected '%v' but got '%v'", ErrBadRandomness, err)
+	}
+	if _, err := GeneratePrivateKeyFrom(bytes.NewReader(valid[:10])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected '%v' but got '%v'", io.ErrUnexpectedEOF, err)
+	}
+}
@@ -2,30 +2,39 @@ package ecdh
 
 import (
 	"crypto/sha256"
-	"math/big"
 
 	"github.com/elnosh/secp256k1"
 )
 
 func Ecdh(privateKey *secp256k1.PrivateKey, publicKey *secp256k1.PublicKey) (*secp256k1.PrivateKey, error) {
-	scalar, err := secp256k1.NewScalar(privateKey.SecretKey.N)
-	if err != nil {
+	// reject invalid points, multiplying a point that is not on the
+	// curve could leak the private key (invalid curve attack)
+	if err := publicKey.Validate(); err != nil {
 		return nil, err
 	}
 
-	publicKeyPoint := publicKey.Point.Copy()
-
-	sharedPoint := secp256k1.ScalarMult(scalar, publicKeyPoint)
-
-	// compute shared secret key by hashing x-coordinate of shared point
-	hash := sha256.Sum256(sharedPoint.X.Value.Bytes())
+	sharedPoint := secp256k1.ScalarMult(privateKey.SecretKey, publicKey.Point)
+	defer sharedPoint.Set(&secp256k1.Point{})
+	if sharedPoint.InfinityPoint {
+		return nil, secp256k1.ErrPointAtInfinity
+	}
 
-	bigint := new(big.Int).SetBytes(hash[:])
-	sharedScalar, err := secp256k1.NewScalar(bigint)
-	if err != nil {
-		return nil, err
+	// compute shared secret key by hashing x-coordinate of shared point.
+	// x is hashed without leading zeros as it always has been, changing
+	// it would derive different keys than earlier versions
+	x := sharedPoint.X.Bytes()
+	defer clear(x[:])
+	i := 0
+	for i < len(x) && x[i] == 0 {
+		i++
 	}
+	hash := sha256.Sum256(x[i:])
+	defer clear(hash[:])
+
+	// NewPrivateKey copies the scalar so this one can be wiped
+	sharedScalar := new(secp256k1.Scalar)
+	defer sharedScalar.Zero()
+	sharedScalar.SetBytes(&hash)
 
-	sharedKey := secp256k1.NewPrivateKey(sharedScalar)
-	return sharedKey, nil
+	return secp256k1.NewPrivateKey(sharedScalar)
 }
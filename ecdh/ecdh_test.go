@@ -1,6 +1,7 @@
 package ecdh
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -30,8 +31,14 @@ func TestEcdh(t *testing.T) {
 		aliceScalar, _ := secp256k1.NewScalar(aliceInt)
 		bobScalar, _ := secp256k1.NewScalar(bobInt)
 
-		aliceKey := secp256k1.NewPrivateKey(aliceScalar)
-		bobKey := secp256k1.NewPrivateKey(bobScalar)
+		aliceKey, err := secp256k1.NewPrivateKey(aliceScalar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bobKey, err := secp256k1.NewPrivateKey(bobScalar)
+		if err != nil {
+			t.Fatal(err)
+		}
 
 		// alice private key * bob public key
 		sharedKey1, err := Ecdh(aliceKey, bobKey.PublicKey)
@@ -46,9 +53,77 @@ func TestEcdh(t *testing.T) {
 		}
 
 		// derived shared keys should be equal
-		if sharedKey1.SecretKey.N.Cmp(sharedKey2.SecretKey.N) != 0 {
+		if !sharedKey1.SecretKey.Equal(sharedKey2.SecretKey) {
 			t.Fatalf("derived shared keys do not match. Alice derived shared key '%x' and Bob derived '%x'",
-				sharedKey1.SecretKey.N.Bytes(), sharedKey2.SecretKey.N.Bytes())
+				sharedKey1.SecretKey.Bytes(), sharedKey2.SecretKey.Bytes())
 		}
 	}
 }
+
+func TestEcdhKnownAnswer(t *testing.T) {
+	// expected keys are sha256 of x of the shared point without leading
+	// zeros, computed with an independent implementation. In the second
+	// case x starts with a zero byte
+	tests := []struct {
+		aliceKey string
+		bobKey   string
+		expected string
+	}{
+		{
+			aliceKey: "1234567890abcdef",
+			bobKey:   "fedcba0987654321",
+			expected: "24d36764b81da978a41c94e0d0b2b2ff09f98ff9436c48a4bcb64d061d2b7e52",
+		},
+		{
+			aliceKey: "1234567890abcdef",
+			bobKey:   "7b",
+			expected: "3aed58a9eb6d5f4681eb9e6e8bb0a11ca44cb6bc743e17e032bc5806eba1625a",
+		},
+	}
+
+	for _, test := range tests {
+		aliceInt, _ := new(big.Int).SetString(test.aliceKey, 16)
+		bobInt, _ := new(big.Int).SetString(test.bobKey, 16)
+
+		aliceScalar, _ := secp256k1.NewScalar(aliceInt)
+		bobScalar, _ := secp256k1.NewScalar(bobInt)
+
+		aliceKey, err := secp256k1.NewPrivateKey(aliceScalar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bobKey, err := secp256k1.NewPrivateKey(bobScalar)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sharedKey, err := Ecdh(aliceKey, bobKey.PublicKey)
+		if err != nil {
+			t.Fatalf("error doing ecdh: %v", err)
+		}
+		if key := sharedKey.RevealSecret(); key != test.expected {
+			t.Fatalf("expected '%v' but got '%v'", test.expected, key)
+		}
+	}
+}
+
+func TestEcdhInvalidPublicKey(t *testing.T) {
+	scalar, _ := secp256k1.NewScalar(big.NewInt(12345))
+	key, err := secp256k1.NewPrivateKey(scalar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x := secp256k1.NewFieldElement(big.NewInt(1))
+	y := secp256k1.NewFieldElement(big.NewInt(1))
+	offCurve := &secp256k1.PublicKey{Point: &secp256k1.Point{X: *x, Y: *y, InfinityPoint: false}}
+
+	if _, err := Ecdh(key, offCurve); !errors.Is(err, secp256k1.ErrPointNotOnCurve) {
+		t.Fatalf("expected '%v' but got '%v'", secp256k1.ErrPointNotOnCurve, err)
+	}
+
+	infinity := &secp256k1.PublicKey{Point: &secp256k1.Point{InfinityPoint: true}}
+	if _, err := Ecdh(key, infinity); !errors.Is(err, secp256k1.ErrPointAtInfinity) {
+		t.Fatalf("expected '%v' but got '%v'", secp256k1.ErrPointAtInfinity, err)
+	}
+}
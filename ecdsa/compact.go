@@ -0,0 +1,66 @@
+package ecdsa
+
+import "errors"
+
+var ErrSigInvalidLength = errors.New("invalid signature length")
+
+// CompactSigLen is the length of a signature as r and s of 32 bytes each
+const CompactSigLen = 64
+
+// SerializeCompact returns the signature as the 32 bytes of r followed
+// by the 32 bytes of s, the format used by Lightning messages
+func (sig *Signature) SerializeCompact() [CompactSigLen]byte {
+	var b [CompactSigLen]byte
+	sig.putRS(b[:])
+	return b
+}
+
+// ParseCompactSignature parses a signature in the format of
+// SerializeCompact. r and s have to be in [1, n-1]
+func ParseCompactSignature(b []byte) (*Signature, error) {
+	if len(b) != CompactSigLen {
+		return nil, ErrSigInvalidLength
+	}
+	sig := new(Signature)
+	if err := sig.setRS(b); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// CompactToDER converts a compact signature to strict DER
+func CompactToDER(b []byte) ([]byte, error) {
+	sig, err := ParseCompactSignature(b)
+	if err != nil {
+		return nil, err
+	}
+	return sig.SerializeDER(), nil
+}
+
+// DERToCompact converts a strict DER signature to the compact format
+func DERToCompact(der []byte) ([CompactSigLen]byte, error) {
+	sig, err := ParseDERSignature(der)
+	if err != nil {
+		return [CompactSigLen]byte{}, err
+	}
+	return sig.SerializeCompact(), nil
+}
+
+// putRS writes r and s as 32 bytes each
+func (sig *Signature) putRS(b []byte) {
+	r := sig.r.Bytes()
+	s := sig.s.Bytes()
+	copy(b[:32], r[:])
+	copy(b[32:64], s[:])
+}
+
+// setRS reads r and s from 32 bytes each, they have to be in [1, n-1]
+func (sig *Signature) setRS(b []byte) error {
+	var r, s [32]byte
+	copy(r[:], b[:32])
+	copy(s[:], b[32:64])
+	if sig.r.SetBytes(&r) || sig.s.SetBytes(&s) || sig.r.IsZero() || sig.s.IsZero() {
+		return ErrSigOutOfRange
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package ecdsa
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCompactSignature(t *testing.T) {
+	// the RFC 6979 signature for key 1 and "Satoshi Nakamoto"
+	r := "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8"
+	s := "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5"
+	compact, _ := hex.DecodeString(r + s)
+	der, _ := hex.DecodeString("3045022100" + r + "0220" + s)
+
+	sig, err := ParseCompactSignature(compact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := sig.SerializeCompact(); !bytes.Equal(b[:], compact) {
+		t.Fatalf("expected '%x' but got '%x'", compact, b)
+	}
+
+	toDER, err := CompactToDER(compact)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(toDER, der) {
+		t.Fatalf("expected '%x' but got '%x'", der, toDER)
+	}
+	toCompact, err := DERToCompact(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(toCompact[:], compact) {
+		t.Fatalf("expected '%x' but got '%x'", compact, toCompact)
+	}
+
+	n := "fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+	zero := "0000000000000000000000000000000000000000000000000000000000000000"
+
+	tests := []struct {
+		name string
+		sig  string
+		err  error
+	}{
+		{"short", r + s[:62], ErrSigInvalidLength},
+		{"long", r + s + "00", ErrSigInvalidLength},
+		{"zero r", zero + s, ErrSigOutOfRange},
+		{"zero s", r + zero, ErrSigOutOfRange},
+		{"r is n", n + s, ErrSigOutOfRange},
+		{"s is n", r + n, ErrSigOutOfRange},
+	}
+
+	for _, test := range tests {
+		b, _ := hex.DecodeString(test.sig)
+		if _, err := ParseCompactSignature(b); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+		if _, err := CompactToDER(b); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+	}
+
+	if _, err := DERToCompact(der[:len(der)-1]); !errors.Is(err, ErrSigInvalidDER) {
+		t.Fatalf("expected '%v' but got '%v'", ErrSigInvalidDER, err)
+	}
+}
@@ -0,0 +1,212 @@
+package ecdsa
+
+import (
+	"errors"
+
+	"github.com/elnosh/secp256k1"
+)
+
+const (
+	derSequence = 0x30
+	derInteger  = 0x02
+
+	// 0x30 len 0x02 len r 0x02 len s with r and s of up to 33 bytes
+	minDERSigLen = 8
+	maxDERSigLen = 72
+)
+
+var (
+	ErrSigInvalidDER = errors.New("invalid DER signature encoding")
+	ErrSigNegative   = errors.New("signature value is negative")
+	ErrSigPadding    = errors.New("signature value has excess padding")
+	ErrSigOutOfRange = errors.New("signature value is zero or not less than n")
+)
+
+// R returns the r value of the signature
+func (sig *Signature) R() *secp256k1.Scalar {
+	return new(secp256k1.Scalar).Set(&sig.r)
+}
+
+// S returns the s value of the signature
+func (sig *Signature) S() *secp256k1.Scalar {
+	return new(secp256k1.Scalar).Set(&sig.s)
+}
+
+// SerializeDER returns the signature encoded as a DER sequence of the two
+// integers r and s, in the strict form required by BIP-66
+func (sig *Signature) SerializeDER() []byte {
+	r := derInt(&sig.r)
+	s := derInt(&sig.s)
+
+	b := make([]byte, 0, 6+len(r)+len(s))
+	b = append(b, derSequence, byte(4+len(r)+len(s)))
+	b = append(b, derInteger, byte(len(r)))
+	b = append(b, r...)
+	b = append(b, derInteger, byte(len(s)))
+	b = append(b, s...)
+	return b
+}
+
+// derInt returns the minimal big-endian encoding of v as a positive
+// DER integer: no leading zeros except one if the high bit is set
+func derInt(v *secp256k1.Scalar) []byte {
+	b := v.Bytes()
+	i := 0
+	for i < len(b)-1 && b[i] == 0 {
+		i++
+	}
+	if b[i]&0x80 != 0 {
+		return append([]byte{0x00}, b[i:]...)
+	}
+	return b[i:]
+}
+
+// ParseDERSignature parses a signature in the strict DER encoding of
+// BIP-66: the lengths have to match the data exactly, integers can not be
+// negative or have leading zero bytes that are not needed, and there can
+// not be anything after the signature. r and s have to be in [1, n-1].
+func ParseDERSignature(b []byte) (*Signature, error) {
+	if len(b) < minDERSigLen || len(b) > maxDERSigLen {
+		return nil, ErrSigInvalidDER
+	}
+	if b[0] != derSequence || int(b[1]) != len(b)-2 {
+		return nil, ErrSigInvalidDER
+	}
+
+	// r starts at 4 and s right after it
+	rLen := int(b[3])
+	if b[2] != derInteger || rLen == 0 || 5+rLen >= len(b) {
+		return nil, ErrSigInvalidDER
+	}
+	sLen := int(b[5+rLen])
+	if b[4+rLen] != derInteger || sLen == 0 || 6+rLen+sLen != len(b) {
+		return nil, ErrSigInvalidDER
+	}
+
+	var sig Signature
+	if err := parseDERInt(&sig.r, b[4:4+rLen]); err != nil {
+		return nil, err
+	}
+	if err := parseDERInt(&sig.s, b[6+rLen:]); err != nil {
+		return nil, err
+	}
+	return &sig, nil
+}
+
+func parseDERInt(v *secp256k1.Scalar, b []byte) error {
+	if b[0]&0x80 != 0 {
+		return ErrSigNegative
+	}
+	// a leading zero is only allowed to keep the next byte from
+	// looking negative
+	if len(b) > 1 && b[0] == 0 && b[1]&0x80 == 0 {
+		return ErrSigPadding
+	}
+	if !setScalarBytes(v, b) || v.IsZero() {
+		return ErrSigOutOfRange
+	}
+	return nil
+}
+
+// ParseDERSignatureLax parses signatures that are not valid strict DER
+// but are found in old blockchain data, following what libsecp256k1 does
+// for them: lengths can use the long form, integers can have any amount of
+// padding and their sign bit is ignored, the sequence length is not
+// checked and data after the signature is ignored. If r or s is not less
+// than n both are set to zero, so like zero values the signature parses
+// but fails to verify.
+func ParseDERSignatureLax(b []byte) (*Signature, error) {
+	if len(b) == 0 || b[0] != derSequence {
+		return nil, ErrSigInvalidDER
+	}
+	pos := 1
+
+	// the sequence length is skipped
+	if pos == len(b) {
+		return nil, ErrSigInvalidDER
+	}
+	lenByte := int(b[pos])
+	pos++
+	if lenByte&0x80 != 0 {
+		lenByte -= 0x80
+		if lenByte > len(b)-pos {
+			return nil, ErrSigInvalidDER
+		}
+		pos += lenByte
+	}
+
+	var sig Signature
+	overflow := false
+	for _, v := range []*secp256k1.Scalar{&sig.r, &sig.s} {
+		if pos == len(b) || b[pos] != derInteger {
+			return nil, ErrSigInvalidDER
+		}
+		pos++
+
+		n, next, ok := readLaxLength(b, pos)
+		if !ok || n > len(b)-next {
+			return nil, ErrSigInvalidDER
+		}
+		pos = next
+
+		value := b[pos : pos+n]
+		for len(value) > 0 && value[0] == 0 {
+			value = value[1:]
+		}
+		if !setScalarBytes(v, value) {
+			overflow = true
+		}
+		pos += n
+	}
+
+	if overflow {
+		sig = Signature{}
+	}
+	return &sig, nil
+}
+
+// readLaxLength reads a DER length at pos in the short or long form,
+// allowing leading zeros in the long form
+func readLaxLength(b []byte, pos int) (int, int, bool) {
+	if pos == len(b) {
+		return 0, 0, false
+	}
+	lenByte := int(b[pos])
+	pos++
+	if lenByte&0x80 == 0 {
+		return lenByte, pos, true
+	}
+
+	lenByte -= 0x80
+	if lenByte > len(b)-pos {
+		return 0, 0, false
+	}
+	for lenByte > 0 && b[pos] == 0 {
+		pos++
+		lenByte--
+	}
+	// anything longer can not fit in the signature anyway
+	if lenByte > 3 {
+		return 0, 0, false
+	}
+	n := 0
+	for ; lenByte > 0; lenByte-- {
+		n = n<<8 | int(b[pos])
+		pos++
+	}
+	return n, pos, true
+}
+
+// setScalarBytes sets v to the big-endian value in b. It returns false
+// if the value does not fit or is not less than n
+func setScalarBytes(v *secp256k1.Scalar, b []byte) bool {
+	if len(b) > 33 || (len(b) == 33 && b[0] != 0) {
+		return false
+	}
+	if len(b) == 33 {
+		b = b[1:]
+	}
+	var buf [32]byte
+	copy(buf[32-len(b):], b)
+	return !v.SetBytes(&buf)
+}
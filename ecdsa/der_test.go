@@ -0,0 +1,144 @@
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/elnosh/secp256k1"
+)
+
+func TestSerializeDER(t *testing.T) {
+	// r and s with the high bit set get a zero byte, small values
+	// drop their leading zeros
+	tests := []struct {
+		r        uint64
+		s        uint64
+		expected string
+	}{
+		{1, 1, "3006020101020101"},
+		{0x80, 0x7f, "300702020080" + "02017f"},
+		{0x1234, 0xff00, "300902021234020300ff00"},
+	}
+
+	for _, test := range tests {
+		sig := &Signature{r: *new(secp256k1.Scalar).SetInt(test.r), s: *new(secp256k1.Scalar).SetInt(test.s)}
+		der := hex.EncodeToString(sig.SerializeDER())
+		if der != test.expected {
+			t.Fatalf("expected '%v' but got '%v'", test.expected, der)
+		}
+	}
+}
+
+func TestParseDERSignature(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 20; i++ {
+		hash := sha256.Sum256([]byte{byte(i)})
+		sig, err := Sign(key, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		der := sig.SerializeDER()
+		parsed, err := ParseDERSignature(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !parsed.R().Equal(sig.R()) || !parsed.S().Equal(sig.S()) {
+			t.Fatal("parsed signature does not match")
+		}
+		if !parsed.Verify(key.PublicKey, hash[:]) {
+			t.Fatal("invalid signature")
+		}
+
+		lax, err := ParseDERSignatureLax(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !lax.R().Equal(sig.R()) || !lax.S().Equal(sig.S()) {
+			t.Fatal("lax parsed signature does not match")
+		}
+	}
+
+	n := "00fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141"
+
+	tests := []struct {
+		name   string
+		sig    string
+		strict error
+		lax    error
+	}{
+		{"valid", "3006020101020101", nil, nil},
+		{"too short", "30050201010201", ErrSigInvalidDER, ErrSigInvalidDER},
+		{"not a sequence", "3106020101020101", ErrSigInvalidDER, ErrSigInvalidDER},
+		{"wrong sequence length", "3007020101020101", ErrSigInvalidDER, nil},
+		{"trailing data", "300602010102010100", ErrSigInvalidDER, nil},
+		{"r not an integer", "3006030101020101", ErrSigInvalidDER, ErrSigInvalidDER},
+		{"s not an integer", "3006020101030101", ErrSigInvalidDER, ErrSigInvalidDER},
+		{"zero length r", "30050200020101", ErrSigInvalidDER, nil},
+		{"r too long", "3006020201020101", ErrSigInvalidDER, ErrSigInvalidDER},
+		{"long form length", "300702810101020101", ErrSigInvalidDER, nil},
+		{"long form sequence", "30810602010102010100", ErrSigInvalidDER, nil},
+		{"negative r", "3006020181020101", ErrSigNegative, nil},
+		{"negative s", "3006020101020181", ErrSigNegative, nil},
+		{"padded r", "300702020001020101", ErrSigPadding, nil},
+		{"padded s", "300702010102020001", ErrSigPadding, nil},
+		{"zero r", "3006020100020101", ErrSigOutOfRange, nil},
+		{"r is n", "302602" + "21" + n + "020101", ErrSigOutOfRange, nil},
+	}
+
+	for _, test := range tests {
+		b, _ := hex.DecodeString(test.sig)
+		if _, err := ParseDERSignature(b); !errors.Is(err, test.strict) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.strict, err)
+		}
+		if _, err := ParseDERSignatureLax(b); !errors.Is(err, test.lax) {
+			t.Fatalf("%v: expected lax '%v' but got '%v'", test.name, test.lax, err)
+		}
+	}
+
+	// values not less than n make both r and s zero so the signature
+	// parses but can not verify
+	hash := sha256.Sum256([]byte{0})
+	valid, err := Sign(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	validR := valid.r.Bytes()
+	for _, overflowing := range []string{
+		"304502" + "21" + n + "0220" + hex.EncodeToString(validR[:]),
+		"3045" + "0220" + hex.EncodeToString(validR[:]) + "0221" + n,
+		"302702" + "22" + "0001" + n[2:] + "020101",
+	} {
+		b, _ := hex.DecodeString(overflowing)
+		sig, err := ParseDERSignatureLax(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sig.R().IsZero() || !sig.S().IsZero() {
+			t.Fatalf("expected zero r and s for '%v'", overflowing)
+		}
+		if sig.Verify(key.PublicKey, hash[:]) {
+			t.Fatal("expected overflowing signature to fail")
+		}
+	}
+
+	// the lax parser strips padding and reads long form lengths
+	b, _ := hex.DecodeString("30810c020400000005028200020007")
+	sig, err := ParseDERSignatureLax(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.R().Equal(new(secp256k1.Scalar).SetInt(5)) || !sig.S().Equal(new(secp256k1.Scalar).SetInt(7)) {
+		t.Fatal("expected r = 5 and s = 7")
+	}
+	if !bytes.Equal(sig.SerializeDER(), []byte{0x30, 0x06, 0x02, 0x01, 0x05, 0x02, 0x01, 0x07}) {
+		t.Fatalf("expected strict encoding but got '%x'", sig.SerializeDER())
+	}
+}
@@ -1,81 +1,173 @@
 package ecdsa
 
 import (
-	"math/big"
+	"io"
 
 	"github.com/elnosh/secp256k1"
 )
 
 type Signature struct {
-	// both r and s will be modulo n so I could
-	// use Scalar type here instead big.Int
-	r *big.Int
-	s *big.Int
+	r secp256k1.Scalar
+	s secp256k1.Scalar
 }
 
+// Sign signs hash with a deterministic nonce derived from the key and
+// the hash as described in RFC 6979, so signing the same hash with the
+// same key always gives the same signature
 func Sign(key *secp256k1.PrivateKey, hash []byte) (*Signature, error) {
-	k, err := secp256k1.GeneratePrivateKey()
+	sig, err := signRFC6979(key, hash, nil)
 	if err != nil {
 		return nil, err
 	}
+	return &sig.Signature, nil
+}
+
+// SignWithEntropy is like Sign but mixes 32 bytes of extra data into the
+// nonce derivation, as Bitcoin Core does to grind signatures or to add
+// randomness without depending on it for security
+func SignWithEntropy(key *secp256k1.PrivateKey, hash []byte, extra [32]byte) (*Signature, error) {
+	sig, err := signRFC6979(key, hash, &extra)
+	if err != nil {
+		return nil, err
+	}
+	return &sig.Signature, nil
+}
 
-	// r is the x value of the ephemeral key
-	r := new(big.Int).Set(k.PublicKey.X.Value)
-	e := new(big.Int).SetBytes(hash)
+func signRFC6979(key *secp256k1.PrivateKey, hash []byte, extra *[32]byte) (*RecoverableSignature, error) {
+	// s = k^-1 * e would be zero for every nonce with a zero key and
+	// hash, the nonce loop would never end
+	if key.SecretKey.IsZero() {
+		return nil, secp256k1.ErrPrivKeyZero
+	}
 
-	// signature s = k^-1 (e+r*key) mod n
-	s := new(big.Int)
-	s.Mul(r, key.SecretKey.N).Add(s, e)
-	kinverse := new(big.Int)
-	kinverse.ModInverse(k.SecretKey.N, secp256k1.Curve.N)
-	s.Mul(s, kinverse).Mod(s, secp256k1.Curve.N)
+	var e secp256k1.Scalar
+	e.SetByteSlice(hash)
+	eBytes := e.Bytes()
 
-	return &Signature{r: r, s: s}, nil
+	drbg := newRFC6979(key.SecretKey, &eBytes, extra)
+	defer drbg.zero()
+
+	for {
+		k := drbg.nonce()
+		sig, ok := signWithNonce(key, &e, k)
+		k.Zero()
+		if ok {
+			return sig, nil
+		}
+	}
 }
 
-func (s *Signature) Verify(publicKey *secp256k1.PublicKey, hash []byte) bool {
-	if s.r.Cmp(secp256k1.Curve.N) > 0 || s.s.Cmp(secp256k1.Curve.N) > 0 {
-		return false
+// SignWithRand is like Sign but reads the nonce from random instead of
+// deriving it. The nonce must be secret and never reused, anyone who
+// learns it or sees two signatures with the same one can compute the
+// private key.
+func SignWithRand(random io.Reader, key *secp256k1.PrivateKey, hash []byte) (*Signature, error) {
+	if key.SecretKey.IsZero() {
+		return nil, secp256k1.ErrPrivKeyZero
 	}
 
-	e := new(big.Int).SetBytes(hash)
+	var e secp256k1.Scalar
+	e.SetByteSlice(hash)
+
+	for {
+		k, err := secp256k1.GenerateScalarFrom(random)
+		if err != nil {
+			return nil, err
+		}
+		sig, ok := signWithNonce(key, &e, k)
+		k.Zero()
+		if ok {
+			return &sig.Signature, nil
+		}
+	}
+}
 
-	// u1 = es^-1 mod n
-	sinverse := new(big.Int).ModInverse(s.s, secp256k1.Curve.N)
-	u1 := new(big.Int)
-	u1.Mul(e, sinverse).Mod(u1, secp256k1.Curve.N)
+// signWithNonce signs e with the nonce k. It returns false if r or s
+// is zero, in which case another nonce has to be used
+func signWithNonce(key *secp256k1.PrivateKey, e, k *secp256k1.Scalar) (*RecoverableSignature, bool) {
+	// r is the x value of the ephemeral key mod n. The parity of y and
+	// whether x had to be reduced are kept to recover the public key
+	var r secp256k1.Scalar
+	R := secp256k1.BaseScalarMult(k)
+	rBytes := R.X.Bytes()
+	var recoveryID byte
+	if r.SetBytes(&rBytes) {
+		recoveryID |= recoveryOverflowBit
+	}
+	if R.Y.IsOdd() {
+		recoveryID |= recoveryOddBit
+	}
 
-	// u2 = rs^-1 mod n
-	u2 := new(big.Int)
-	u2.Mul(s.r, sinverse).Mod(u2, secp256k1.Curve.N)
+	// signature s = k^-1 (e+r*key) mod n
+	var s secp256k1.Scalar
+	s.Mul(&r, key.SecretKey).Add(&s, e)
+	kinverse := new(secp256k1.Scalar).Inverse(k)
+	s.Mul(&s, kinverse)
 
-	u1Scalar, err := secp256k1.NewScalar(u1)
-	if err != nil {
+	// anyone who knows the nonce can recover the key from the signature
+	kinverse.Zero()
+
+	// a zero r or s would not verify
+	if r.IsZero() || s.IsZero() {
+		return nil, false
+	}
+	sig := &RecoverableSignature{Signature: Signature{r: r, s: s}, recoveryID: recoveryID}
+	return sig.Normalize(), true
+}
+
+// IsLowS reports whether s is not greater than n/2. Both s and n-s
+// verify, Bitcoin only relays the low one so signatures can not be
+// changed by a third party (BIP-146)
+func (sig *Signature) IsLowS() bool {
+	return !sig.s.IsHigh()
+}
+
+// Normalize replaces s with n-s if it is high and returns sig. The
+// signature stays valid
+func (sig *Signature) Normalize() *Signature {
+	if sig.s.IsHigh() {
+		sig.s.Negate(&sig.s)
+	}
+	return sig
+}
+
+// Verify reports whether the signature is valid for hash and publicKey.
+// Signatures with a high s are accepted, use VerifyStrict to reject them
+func (s *Signature) Verify(publicKey *secp256k1.PublicKey, hash []byte) bool {
+	if publicKey.Validate() != nil {
 		return false
 	}
 
-	u2Scalar, err := secp256k1.NewScalar(u2)
-	if err != nil {
+	if s.r.IsZero() || s.s.IsZero() {
 		return false
 	}
 
-	//  u1*G
-	u1Point := secp256k1.BaseScalarMult(u1Scalar)
-	// u2*PublicKey
-	u2PubKeyPoint := secp256k1.ScalarMult(u2Scalar, publicKey.Point)
+	var e secp256k1.Scalar
+	e.SetByteSlice(hash)
 
-	// R = u1*G + u2*PublicKey
-	RPoint := new(secp256k1.Point)
-	RPoint = RPoint.Add(u1Point, u2PubKeyPoint)
+	// u1 = es^-1 mod n
+	sinverse := new(secp256k1.Scalar).Inverse(&s.s)
+	u1 := new(secp256k1.Scalar).Mul(&e, sinverse)
 
-	// u = R.x
-	u := new(big.Int).Set(RPoint.X.Value)
-	u.Mod(u, secp256k1.Curve.N)
+	// u2 = rs^-1 mod n
+	u2 := new(secp256k1.Scalar).Mul(&s.r, sinverse)
 
-	// signature is valid if u == r
-	if u.Cmp(s.r) != 0 {
+	// R = u1*G + u2*PublicKey
+	RPoint := secp256k1.DoubleScalarMultBase(u1, u2, publicKey.Point)
+	if RPoint.InfinityPoint {
 		return false
 	}
 
-	return true
+	// u = R.x mod n
+	var u secp256k1.Scalar
+	xBytes := RPoint.X.Bytes()
+	u.SetBytes(&xBytes)
+
+	// signature is valid if u == r
+	return u.Equal(&s.r)
+}
+
+// VerifyStrict is like Verify but also rejects signatures with a high s
+func (s *Signature) VerifyStrict(publicKey *secp256k1.PublicKey, hash []byte) bool {
+	return s.IsLowS() && s.Verify(publicKey, hash)
 }
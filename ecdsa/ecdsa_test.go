@@ -1,8 +1,10 @@
 package ecdsa
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -16,7 +18,14 @@ func TestVerifySignature(t *testing.T) {
 	y, _ := new(big.Int).SetString("61de6d95231cd89026e286df3b6ae4a894a3378e393e93a0f45b666329a0ae34", 16)
 	xelement := secp256k1.NewFieldElement(x)
 	yelement := secp256k1.NewFieldElement(y)
-	pubkey := &secp256k1.PublicKey{&secp256k1.Point{X: xelement, Y: yelement, InfinityPoint: false}}
+	point, err := secp256k1.NewPoint(xelement, yelement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := &secp256k1.PublicKey{Point: point}
+
+	// same x with a y that is not on the curve
+	offCurve := &secp256k1.PublicKey{Point: &secp256k1.Point{X: *xelement, Y: *xelement, InfinityPoint: false}}
 
 	tests := []struct {
 		r         string
@@ -39,6 +48,20 @@ func TestVerifySignature(t *testing.T) {
 			hash:      "7c076ff316692a3d7eb3c3bb0f8b1488cf72e1afcd929e29307032997a838a3d",
 			want:      true,
 		},
+		{
+			r:         "ac8d1c87e51d0d441be8b3dd5b05c8795b48875dffe00b7ffcfac23010d3a395",
+			s:         "68342ceff8935ededd102dd876ffd6ba72d6a427a3edb13d26eb0781cb423c4",
+			publicKey: offCurve,
+			hash:      "ec208baa0fc1c19f708a9ca96fdeff3ac3f230bb4a7ba4aede4942ad003c0f60",
+			want:      false,
+		},
+		{
+			r:         "ac8d1c87e51d0d441be8b3dd5b05c8795b48875dffe00b7ffcfac23010d3a395",
+			s:         "68342ceff8935ededd102dd876ffd6ba72d6a427a3edb13d26eb0781cb423c4",
+			publicKey: &secp256k1.PublicKey{Point: &secp256k1.Point{InfinityPoint: true}},
+			hash:      "ec208baa0fc1c19f708a9ca96fdeff3ac3f230bb4a7ba4aede4942ad003c0f60",
+			want:      false,
+		},
 		{
 			r:         "aff69ef2b1bd93a66ed5219add4fb51a29348729587498572498572498457bbb",
 			s:         "b7207fee197d27c618aea621406f6bf5ef6fca382398472398742398472398cc",
@@ -49,9 +72,11 @@ func TestVerifySignature(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		r, _ := new(big.Int).SetString(test.r, 16)
-		s, _ := new(big.Int).SetString(test.s, 16)
-		sig := &Signature{r: r, s: s}
+		rInt, _ := new(big.Int).SetString(test.r, 16)
+		sInt, _ := new(big.Int).SetString(test.s, 16)
+		r, _ := secp256k1.NewScalar(rInt)
+		s, _ := secp256k1.NewScalar(sInt)
+		sig := &Signature{r: *r, s: *s}
 		hash, _ := hex.DecodeString(test.hash)
 
 		result := sig.Verify(test.publicKey, hash)
@@ -80,3 +105,113 @@ func TestSignAndVerify(t *testing.T) {
 		t.Fatal("invalid signature")
 	}
 }
+
+func TestSignWithRand(t *testing.T) {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("hello"))
+	nonce := bytes.Repeat([]byte{0x07}, 32)
+
+	// the same nonce gives the same signature
+	sig1, err := SignWithRand(bytes.NewReader(nonce), privateKey, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig2, err := SignWithRand(bytes.NewReader(nonce), privateKey, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig1.r.Equal(&sig2.r) || !sig1.s.Equal(&sig2.s) {
+		t.Fatal("expected the same signature")
+	}
+	if !sig1.Verify(privateKey.PublicKey, hash[:]) {
+		t.Fatal("invalid signature")
+	}
+
+	// r is the x-coordinate of nonce*G
+	k, _ := secp256k1.GeneratePrivateKeyFrom(bytes.NewReader(nonce))
+	rBytes := k.PublicKey.X.Bytes()
+	var r secp256k1.Scalar
+	r.SetBytes(&rBytes)
+	if !sig1.r.Equal(&r) {
+		t.Fatal("r does not match the nonce")
+	}
+
+	if _, err := SignWithRand(bytes.NewReader(nil), privateKey, hash[:]); err == nil {
+		t.Fatal("expected error with an empty random source")
+	}
+}
+
+func TestSignZeroKey(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key.Zero()
+
+	// with a zero hash every nonce would give s = 0
+	var hash [32]byte
+	nonce := bytes.Repeat([]byte{0x07}, 32)
+	sign := map[string]func() error{
+		"Sign": func() error {
+			_, err := Sign(key, hash[:])
+			return err
+		},
+		"SignWithEntropy": func() error {
+			_, err := SignWithEntropy(key, hash[:], [32]byte{1})
+			return err
+		},
+		"SignWithRand": func() error {
+			_, err := SignWithRand(bytes.NewReader(nonce), key, hash[:])
+			return err
+		},
+		"SignRecoverable": func() error {
+			_, err := SignRecoverable(key, hash[:])
+			return err
+		},
+	}
+
+	for name, f := range sign {
+		if err := f(); !errors.Is(err, secp256k1.ErrPrivKeyZero) {
+			t.Fatalf("%v: expected '%v' but got '%v'", name, secp256k1.ErrPrivKeyZero, err)
+		}
+	}
+}
+
+func TestLowS(t *testing.T) {
+	privateKey, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 20; i++ {
+		hash := sha256.Sum256([]byte{byte(i)})
+		sig, err := Sign(privateKey, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sig.IsLowS() || !sig.VerifyStrict(privateKey.PublicKey, hash[:]) {
+			t.Fatal("expected a low s signature")
+		}
+
+		// n - s is also valid but only passes the non strict check
+		high := &Signature{r: sig.r}
+		high.s.Negate(&sig.s)
+		if high.IsLowS() {
+			t.Fatal("expected a high s")
+		}
+		if !high.Verify(privateKey.PublicKey, hash[:]) {
+			t.Fatal("expected high s to verify")
+		}
+		if high.VerifyStrict(privateKey.PublicKey, hash[:]) {
+			t.Fatal("expected high s to be rejected")
+		}
+
+		high.Normalize()
+		if !high.s.Equal(&sig.s) {
+			t.Fatal("expected normalized s to match")
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package ecdsa
+
+import (
+	"errors"
+
+	"github.com/elnosh/secp256k1"
+)
+
+const (
+	// RecoverableSigLen is the length of the compact recoverable
+	// signature formats: a header or v byte and the 32 bytes of r and s
+	RecoverableSigLen = 65
+
+	// the recovery id has the parity of y of the nonce point in bit 0
+	// and in bit 1 whether its x was not less than n
+	recoveryOddBit      = 1
+	recoveryOverflowBit = 2
+	maxRecoveryID       = 3
+
+	// Bitcoin message signatures start with 27 + id, plus 4 when the
+	// key is compressed
+	bitcoinHeaderBase       = 27
+	bitcoinHeaderCompressed = 4
+
+	// Ethereum uses v = 27 + id, or chain id * 2 + 35 + id with EIP-155
+	ethereumLegacyV = 27
+	ethereumEIP155V = 35
+)
+
+var (
+	ErrInvalidRecoveryID = errors.New("invalid recovery id")
+	ErrChainIDTooLarge   = errors.New("chain id does not fit in a 65-byte signature")
+)
+
+// RecoverableSignature is a signature with the recovery id needed to get
+// the public key back from the signature and the hash
+type RecoverableSignature struct {
+	Signature
+	recoveryID byte
+}
+
+// SignRecoverable is like Sign but keeps the recovery id
+func SignRecoverable(key *secp256k1.PrivateKey, hash []byte) (*RecoverableSignature, error) {
+	return signRFC6979(key, hash, nil)
+}
+
+// RecoveryID returns the recovery id in [0, 3]
+func (sig *RecoverableSignature) RecoveryID() byte {
+	return sig.recoveryID
+}
+
+// Normalize replaces s with n-s if it is high and returns sig. That is
+// the signature for the negated nonce so the parity in the recovery id
+// is flipped too
+func (sig *RecoverableSignature) Normalize() *RecoverableSignature {
+	if !sig.IsLowS() {
+		sig.Signature.Normalize()
+		sig.recoveryID ^= recoveryOddBit
+	}
+	return sig
+}
+
+// RecoverPublicKey returns the public key that produced sig for hash:
+// with R the nonce point given by r and the recovery id, the key is
+// r^-1 (s*R - e*G)
+func RecoverPublicKey(sig *RecoverableSignature, hash []byte) (*secp256k1.PublicKey, error) {
+	if sig.recoveryID > maxRecoveryID {
+		return nil, ErrInvalidRecoveryID
+	}
+	if sig.r.IsZero() || sig.s.IsZero() {
+		return nil, ErrSigOutOfRange
+	}
+
+	// x of R is r or r + n, which has to be less than p
+	x := sig.r.BigInt()
+	if sig.recoveryID&recoveryOverflowBit != 0 {
+		x.Add(x, secp256k1.Curve.N)
+		if x.Cmp(secp256k1.Curve.P) >= 0 {
+			return nil, ErrInvalidRecoveryID
+		}
+	}
+	compressed := make([]byte, secp256k1.PubKeyBytesLenCompressed)
+	compressed[0] = 0x02 | sig.recoveryID&recoveryOddBit
+	x.FillBytes(compressed[1:])
+	R, err := secp256k1.ParsePubKey(compressed)
+	if err != nil {
+		return nil, err
+	}
+
+	var e secp256k1.Scalar
+	e.SetByteSlice(hash)
+
+	// u1 = -e*r^-1, u2 = s*r^-1
+	rinverse := new(secp256k1.Scalar).Inverse(&sig.r)
+	u1 := new(secp256k1.Scalar).Mul(&e, rinverse)
+	u1.Negate(u1)
+	u2 := new(secp256k1.Scalar).Mul(&sig.s, rinverse)
+
+	Q := secp256k1.DoubleScalarMultBase(u1, u2, R.Point)
+	if Q.InfinityPoint {
+		return nil, secp256k1.ErrPointAtInfinity
+	}
+	return &secp256k1.PublicKey{Point: Q}, nil
+}
+
+// SerializeBitcoin returns the 65-byte signature used for Bitcoin signed
+// messages: a header of 27 + recovery id, plus 4 if the key is
+// compressed, followed by r and s
+func (sig *RecoverableSignature) SerializeBitcoin(compressed bool) [RecoverableSigLen]byte {
+	var b [RecoverableSigLen]byte
+	b[0] = bitcoinHeaderBase + sig.recoveryID
+	if compressed {
+		b[0] += bitcoinHeaderCompressed
+	}
+	sig.putRS(b[1:])
+	return b
+}
+
+// ParseBitcoinSignature parses a signature in the format of
+// SerializeBitcoin and returns whether the key is compressed
+func ParseBitcoinSignature(b []byte) (*RecoverableSignature, bool, error) {
+	if len(b) != RecoverableSigLen {
+		return nil, false, ErrSigInvalidLength
+	}
+	header := b[0]
+	if header < bitcoinHeaderBase || header > bitcoinHeaderBase+bitcoinHeaderCompressed+maxRecoveryID {
+		return nil, false, ErrInvalidRecoveryID
+	}
+	header -= bitcoinHeaderBase
+	compressed := header&bitcoinHeaderCompressed != 0
+
+	sig := &RecoverableSignature{recoveryID: header &^ bitcoinHeaderCompressed}
+	if err := sig.setRS(b[1:]); err != nil {
+		return nil, false, err
+	}
+	return sig, compressed, nil
+}
+
+// SerializeEthereum returns the signature as r, s and v. With a chain id
+// of 0 v is 27 or 28, otherwise it is chain id * 2 + 35 or 36 as in
+// EIP-155. v has to fit in a byte, larger chain ids need v to be sent
+// separately. Ethereum can not represent a recovery id of 2 or 3.
+func (sig *RecoverableSignature) SerializeEthereum(chainID uint64) ([RecoverableSigLen]byte, error) {
+	var b [RecoverableSigLen]byte
+	if sig.recoveryID > recoveryOddBit {
+		return b, ErrInvalidRecoveryID
+	}
+
+	v, ok := ethereumV(chainID)
+	if !ok {
+		return b, ErrChainIDTooLarge
+	}
+	sig.putRS(b[:64])
+	b[64] = v + sig.recoveryID
+	return b, nil
+}
+
+// ParseEthereumSignature parses a signature in the format of
+// SerializeEthereum. v can be 27 or 28, or use EIP-155 with chainID
+func ParseEthereumSignature(b []byte, chainID uint64) (*RecoverableSignature, error) {
+	if len(b) != RecoverableSigLen {
+		return nil, ErrSigInvalidLength
+	}
+
+	v := b[64]
+	eip155V, ok := ethereumV(chainID)
+	var recoveryID byte
+	switch {
+	case v == ethereumLegacyV || v == ethereumLegacyV+1:
+		recoveryID = v - ethereumLegacyV
+	case chainID != 0 && ok && (v == eip155V || v == eip155V+1):
+		recoveryID = v - eip155V
+	default:
+		return nil, ErrInvalidRecoveryID
+	}
+
+	sig := &RecoverableSignature{recoveryID: recoveryID}
+	if err := sig.setRS(b[:64]); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// ethereumV returns the v for a recovery id of 0. It returns false if
+// v + 1 does not fit in a byte
+func ethereumV(chainID uint64) (byte, bool) {
+	if chainID == 0 {
+		return ethereumLegacyV, true
+	}
+	if chainID > (0xff-1-ethereumEIP155V)/2 {
+		return 0, false
+	}
+	return byte(chainID*2 + ethereumEIP155V), true
+}
@@ -0,0 +1,290 @@
+package ecdsa
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/elnosh/secp256k1"
+)
+
+func TestRecoverPublicKey(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[byte]bool)
+	for i := 0; i < 40; i++ {
+		hash := sha256.Sum256([]byte{byte(i)})
+		sig, err := SignRecoverable(key, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		seen[sig.RecoveryID()] = true
+
+		if !sig.VerifyStrict(key.PublicKey, hash[:]) {
+			t.Fatal("invalid signature")
+		}
+		plain, err := Sign(key, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !plain.r.Equal(&sig.r) || !plain.s.Equal(&sig.s) {
+			t.Fatal("expected the same signature as Sign")
+		}
+
+		recovered, err := RecoverPublicKey(sig, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !recovered.Equal(key.PublicKey.Point) {
+			t.Fatal("recovered key does not match")
+		}
+
+		// the other parity gives a different key
+		other := &RecoverableSignature{Signature: sig.Signature, recoveryID: sig.recoveryID ^ recoveryOddBit}
+		if recovered, err := RecoverPublicKey(other, hash[:]); err != nil || recovered.Equal(key.PublicKey.Point) {
+			t.Fatalf("expected a different key but got '%v'", err)
+		}
+
+		// the high s signature recovers the key with the other parity
+		// and normalizes back to the original one
+		other.s.Negate(&other.s)
+		if recovered, err := RecoverPublicKey(other, hash[:]); err != nil || !recovered.Equal(key.PublicKey.Point) {
+			t.Fatalf("expected the key with high s but got '%v'", err)
+		}
+		other.Normalize()
+		if !other.s.Equal(&sig.s) || other.RecoveryID() != sig.RecoveryID() {
+			t.Fatal("expected normalized signature to match")
+		}
+	}
+	if !seen[0] || !seen[1] {
+		t.Fatal("expected both parities")
+	}
+
+	hash := sha256.Sum256([]byte("hello"))
+	sig, err := SignRecoverable(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		sig  *RecoverableSignature
+		err  error
+	}{
+		{"recovery id 4", &RecoverableSignature{Signature: sig.Signature, recoveryID: 4}, ErrInvalidRecoveryID},
+		// r + n is not less than p for almost every r
+		{"x overflow", &RecoverableSignature{Signature: sig.Signature, recoveryID: sig.recoveryID | recoveryOverflowBit}, ErrInvalidRecoveryID},
+		{"zero r", &RecoverableSignature{Signature: Signature{s: sig.s}}, ErrSigOutOfRange},
+		{"zero s", &RecoverableSignature{Signature: Signature{r: sig.r}}, ErrSigOutOfRange},
+	}
+
+	for _, test := range tests {
+		if _, err := RecoverPublicKey(test.sig, hash[:]); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+	}
+}
+
+func TestRecoverPublicKeyVectors(t *testing.T) {
+	// from the signmessage test in Bitcoin Core (rpc_signmessage.py): the
+	// key of the WIF cUeKHd5orzT3mz8P9pxyREHfsWtVfgsfDjiZZBcjUBAaGk1BTj7N,
+	// whose public key is that of the address mpLQjfK79b7CCV4VMJWEWAj5Mpx8Up5zxB
+	message := "This is just a test message"
+	keyBytes, _ := hex.DecodeString("d2b8a0116d641fe7d3036f8464628fb595b480414c13a301b3d4038c811c28b0")
+	expectedPub := "03c150061989643d77162902b725409087959f15914649d4f06b6cc3f8c87bb238"
+	expectedSig, _ := base64.StdEncoding.DecodeString("INbVnW4e6PeRmsv2Qgu8NuopvrVjkcxob+sX8OcZG0SALhWybUjzMLPdAsXI46YZGb0KQTRii+wWIQzRpG/U+S0=")
+
+	// double sha256 of the magic prefix and the message, both with a
+	// compact size length
+	magic := "Bitcoin Signed Message:\n"
+	data := append([]byte{byte(len(magic))}, magic...)
+	data = append(data, byte(len(message)))
+	data = append(data, message...)
+	first := sha256.Sum256(data)
+	hash := sha256.Sum256(first[:])
+
+	key, err := secp256k1.PrivateKeyFromBytes(keyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := SignRecoverable(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serialized := sig.SerializeBitcoin(true); !bytes.Equal(serialized[:], expectedSig) {
+		t.Fatalf("expected '%x' but got '%x'", expectedSig, serialized)
+	}
+
+	parsed, compressed, err := ParseBitcoinSignature(expectedSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !compressed || parsed.RecoveryID() != 1 {
+		t.Fatalf("expected compressed key with recovery id 1 but got '%v' and '%v'", compressed, parsed.RecoveryID())
+	}
+	recovered, err := RecoverPublicKey(parsed, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub := hex.EncodeToString(recovered.SerializeCompressed()); pub != expectedPub {
+		t.Fatalf("expected '%v' but got '%v'", expectedPub, pub)
+	}
+
+	// R.x = r + n, which needs r < p - n. No signer hits this in practice
+	// so the signature was built from R: with a random s and R = (r + n, y)
+	// for odd y the key is Q = (s*R - e*G) / r, computed independently
+	rBytes, _ := hex.DecodeString("000000000000000000000000000000009530fcd9d6fd1d9b62032801b65c1c29")
+	sBytes, _ := hex.DecodeString("0bbfa9e1ae80b07aabbf3b842b5c138b31b03dd52ad61d54ff8f735c37e06c7c")
+	expectedPub = "0362ddca3a6ba35cf97889dc88bd950e5e840c2e671c6d418ab18ce82c4f038a6a"
+	hash = sha256.Sum256([]byte("overflow"))
+
+	overflow := &RecoverableSignature{recoveryID: recoveryOverflowBit | recoveryOddBit}
+	if err := overflow.setRS(append(rBytes, sBytes...)); err != nil {
+		t.Fatal(err)
+	}
+	recovered, err = RecoverPublicKey(overflow, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub := hex.EncodeToString(recovered.SerializeCompressed()); pub != expectedPub {
+		t.Fatalf("expected '%v' but got '%v'", expectedPub, pub)
+	}
+	if !overflow.Verify(recovered, hash[:]) {
+		t.Fatal("invalid signature")
+	}
+
+	// without the overflow bit R.x = r is a different point
+	overflow.recoveryID = recoveryOddBit
+	if recovered, err := RecoverPublicKey(overflow, hash[:]); err == nil && hex.EncodeToString(recovered.SerializeCompressed()) == expectedPub {
+		t.Fatal("expected a different key without the overflow bit")
+	}
+}
+
+func TestBitcoinSignature(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("hello"))
+	sig, err := SignRecoverable(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, compressed := range []bool{false, true} {
+		b := sig.SerializeBitcoin(compressed)
+		expected := 27 + sig.RecoveryID()
+		if compressed {
+			expected += 4
+		}
+		if b[0] != expected {
+			t.Fatalf("expected header '%v' but got '%v'", expected, b[0])
+		}
+
+		parsed, isCompressed, err := ParseBitcoinSignature(b[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isCompressed != compressed {
+			t.Fatalf("expected '%v' but got '%v'", compressed, isCompressed)
+		}
+		if !parsed.r.Equal(&sig.r) || !parsed.s.Equal(&sig.s) || parsed.RecoveryID() != sig.RecoveryID() {
+			t.Fatal("parsed signature does not match")
+		}
+	}
+
+	valid := sig.SerializeBitcoin(true)
+	tests := []struct {
+		name   string
+		modify func(b []byte) []byte
+		err    error
+	}{
+		{"short", func(b []byte) []byte { return b[:64] }, ErrSigInvalidLength},
+		{"header 26", func(b []byte) []byte { b[0] = 26; return b }, ErrInvalidRecoveryID},
+		{"header 35", func(b []byte) []byte { b[0] = 35; return b }, ErrInvalidRecoveryID},
+		{"zero r", func(b []byte) []byte { clear(b[1:33]); return b }, ErrSigOutOfRange},
+		{"s overflow", func(b []byte) []byte {
+			for i := 33; i < 65; i++ {
+				b[i] = 0xff
+			}
+			return b
+		}, ErrSigOutOfRange},
+	}
+
+	for _, test := range tests {
+		b := valid
+		if _, _, err := ParseBitcoinSignature(test.modify(b[:])); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+	}
+}
+
+func TestEthereumSignature(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("hello"))
+	sig, err := SignRecoverable(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		chainID uint64
+		v       byte
+	}{
+		{0, 27},
+		{1, 37},
+		{109, 253},
+	}
+
+	for _, test := range tests {
+		b, err := sig.SerializeEthereum(test.chainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := test.v + sig.RecoveryID(); b[64] != expected {
+			t.Fatalf("expected v '%v' but got '%v'", expected, b[64])
+		}
+
+		parsed, err := ParseEthereumSignature(b[:], test.chainID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recovered, err := RecoverPublicKey(parsed, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !recovered.Equal(key.PublicKey.Point) {
+			t.Fatal("recovered key does not match")
+		}
+	}
+
+	// legacy v is accepted with any chain id, EIP-155 v only with its own
+	legacy, _ := sig.SerializeEthereum(0)
+	if _, err := ParseEthereumSignature(legacy[:], 1); err != nil {
+		t.Fatal(err)
+	}
+	eip155, _ := sig.SerializeEthereum(1)
+	if _, err := ParseEthereumSignature(eip155[:], 0); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Fatalf("expected '%v' but got '%v'", ErrInvalidRecoveryID, err)
+	}
+	if _, err := ParseEthereumSignature(eip155[:], 2); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Fatalf("expected '%v' but got '%v'", ErrInvalidRecoveryID, err)
+	}
+
+	if _, err := sig.SerializeEthereum(110); !errors.Is(err, ErrChainIDTooLarge) {
+		t.Fatalf("expected '%v' but got '%v'", ErrChainIDTooLarge, err)
+	}
+	overflow := &RecoverableSignature{Signature: sig.Signature, recoveryID: 2}
+	if _, err := overflow.SerializeEthereum(0); !errors.Is(err, ErrInvalidRecoveryID) {
+		t.Fatalf("expected '%v' but got '%v'", ErrInvalidRecoveryID, err)
+	}
+}
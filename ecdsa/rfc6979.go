@@ -0,0 +1,74 @@
+package ecdsa
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"github.com/elnosh/secp256k1"
+)
+
+// rfc6979 is the HMAC-SHA256 DRBG from RFC 6979 section 3.2 used to
+// derive nonces from the private key and the message
+type rfc6979 struct {
+	k, v    [32]byte
+	started bool
+}
+
+// newRFC6979 seeds the generator with key || hash and, if set, the
+// 32 bytes of extra entropy like libsecp256k1 and Bitcoin Core do.
+// hash must already be reduced mod n (bits2octets in the RFC)
+func newRFC6979(key *secp256k1.Scalar, hash *[32]byte, extra *[32]byte) *rfc6979 {
+	d := new(rfc6979)
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+
+	keyBytes := key.Bytes()
+	defer clear(keyBytes[:])
+	seed := [][]byte{keyBytes[:], hash[:]}
+	if extra != nil {
+		seed = append(seed, extra[:])
+	}
+
+	// K = HMAC_K(V || 0x00 || seed), V = HMAC_K(V)
+	// K = HMAC_K(V || 0x01 || seed), V = HMAC_K(V)
+	for _, b := range []byte{0x00, 0x01} {
+		d.hmac(&d.k, append([][]byte{d.v[:], {b}}, seed...)...)
+		d.hmac(&d.v, d.v[:])
+	}
+	return d
+}
+
+// hmac sets out to HMAC_K of the concatenation of the inputs
+func (d *rfc6979) hmac(out *[32]byte, parts ...[]byte) {
+	h := hmac.New(sha256.New, d.k[:])
+	for _, b := range parts {
+		h.Write(b)
+	}
+	h.Sum(out[:0])
+}
+
+// nonce returns the next candidate k in [1, n-1]. Every call after the
+// first one updates K and V before generating, so calling it again gives
+// the next nonce if the previous one produced an invalid signature
+func (d *rfc6979) nonce() *secp256k1.Scalar {
+	k := new(secp256k1.Scalar)
+	for {
+		if d.started {
+			d.hmac(&d.k, d.v[:], []byte{0x00})
+			d.hmac(&d.v, d.v[:])
+		}
+		d.started = true
+
+		// qlen is 256 bits so a single V is enough for T
+		d.hmac(&d.v, d.v[:])
+		if overflow := k.SetBytes(&d.v); !overflow && !k.IsZero() {
+			return k
+		}
+	}
+}
+
+func (d *rfc6979) zero() {
+	clear(d.k[:])
+	clear(d.v[:])
+}
@@ -0,0 +1,226 @@
+package ecdsa
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/elnosh/secp256k1"
+)
+
+func TestSignRFC6979(t *testing.T) {
+	// vectors used by Bitcoin libraries (python-ecdsa, trezor, bitcoinjs)
+	// for RFC 6979 with HMAC-SHA256 over the sha256 of the message
+	nMinusOne := new(big.Int).Sub(secp256k1.Curve.N, big.NewInt(1)).Text(16)
+
+	tests := []struct {
+		key     string
+		message string
+		k       string
+		r       string
+		s       string
+	}{
+		{
+			key:     "1",
+			message: "Satoshi Nakamoto",
+			k:       "8f8a276c19f4149656b280621e358cce24f5f52542772691ee69063b74f15d15",
+			r:       "934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d8",
+			s:       "2442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+		},
+		{
+			key:     "1",
+			message: "All those moments will be lost in time, like tears in rain. Time to die...",
+			k:       "38aa22d72376b4dbc472e06c3ba403ee0a394da63fc58d88686c611aba98d6b3",
+			r:       "8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b",
+			s:       "547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+		},
+		{
+			key:     nMinusOne,
+			message: "Satoshi Nakamoto",
+			k:       "33a19b60e25fb6f4435af53a3d42d493644827367e6453928554f43e49aa6f90",
+			r:       "fd567d121db66e382991534ada77a6bd3106f0a1098c231e47993447cd6af2d0",
+			s:       "6b39cd0eb1bc8603e159ef5c20a5c8ad685a45b06ce9bebed3f153d10d93bed5",
+		},
+		{
+			key:     "f8b8af8ce3c7cca5e300d33939540c10d45ce001b8f252bfbc57ba0342904181",
+			message: "Alan Turing",
+			k:       "525a82b70e67874398067543fd84c83d30c175fdc45fdeee082fe13b1d7cfdf1",
+			r:       "7063ae83e7f62bbb171798131b4a0564b956930092b33b07b395615d9ec7e15c",
+			s:       "58dfcc1e00a35e1572f366ffe34ba0fc47db1e7189759b9fb233c5b05ab388ea",
+		},
+		{
+			key:     "e91671c46231f833a6406ccbea0e3e392c76c167bac1cb013f6f1013980455c2",
+			message: "There is a computer disease that anybody who works with computers knows about. It's a very serious disease and it interferes completely with the work. The trouble with computers is that you 'play' with them!",
+			k:       "1f4b84c23a86a221d233f2521be018d9318639d5b8bbd6374a8a59232d16ad3d",
+			r:       "b552edd27580141f3b2a5463048cb7cd3e047b97c9f98076c32dbdf85a68718b",
+			s:       "279fa72dd19bfae05577e06c7c0c1900c371fcd5893f7e1d56a37d30174671f6",
+		},
+	}
+
+	for _, test := range tests {
+		keyInt, _ := new(big.Int).SetString(test.key, 16)
+		scalar, _ := secp256k1.NewScalar(keyInt)
+		key, err := secp256k1.NewPrivateKey(scalar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := sha256.Sum256([]byte(test.message))
+
+		var e secp256k1.Scalar
+		e.SetByteSlice(hash[:])
+		eBytes := e.Bytes()
+		k := newRFC6979(key.SecretKey, &eBytes, nil).nonce().Bytes()
+		if hex.EncodeToString(k[:]) != test.k {
+			t.Fatalf("expected k '%v' but got '%x'", test.k, k)
+		}
+
+		sig, err := Sign(key, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := sig.r.Bytes()
+		if hex.EncodeToString(r[:]) != test.r {
+			t.Fatalf("expected r '%v' but got '%x'", test.r, r)
+		}
+
+		if s := sig.s.Bytes(); hex.EncodeToString(s[:]) != test.s {
+			t.Fatalf("expected s '%v' but got '%x'", test.s, s)
+		}
+		if !sig.IsLowS() {
+			t.Fatal("expected low s")
+		}
+		if !sig.Verify(key.PublicKey, hash[:]) {
+			t.Fatal("invalid signature")
+		}
+	}
+}
+
+func TestRFC6979Retry(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("hello"))
+
+	// asking again for a nonce gives a different one
+	drbg := newRFC6979(key.SecretKey, &hash, nil)
+	k1 := drbg.nonce()
+	k2 := drbg.nonce()
+	if k1.Equal(k2) {
+		t.Fatal("expected a different nonce")
+	}
+	if k3 := newRFC6979(key.SecretKey, &hash, nil).nonce(); !k3.Equal(k1) {
+		t.Fatal("expected the same first nonce")
+	}
+}
+
+func TestSignWithEntropy(t *testing.T) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte("hello"))
+
+	sig, err := Sign(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := Sign(key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.r.Equal(&again.r) || !sig.s.Equal(&again.s) {
+		t.Fatal("expected the same signature")
+	}
+
+	var extra [32]byte
+	extra[0] = 1
+	withEntropy, err := SignWithEntropy(key, hash[:], extra)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withEntropy.r.Equal(&sig.r) {
+		t.Fatal("expected extra entropy to change the nonce")
+	}
+	if !withEntropy.Verify(key.PublicKey, hash[:]) {
+		t.Fatal("invalid signature")
+	}
+}
+
+func TestSignWithEntropyVectors(t *testing.T) {
+	// libsecp256k1 seeds the DRBG with key || hash || extra. Bitcoin Core
+	// grinds with a little-endian counter as the extra data. The expected
+	// values were computed with an independent implementation of that
+	// nonce function, which also reproduces the vectors in TestSignRFC6979
+	counter1 := "0100000000000000000000000000000000000000000000000000000000000000"
+
+	tests := []struct {
+		key     string
+		message string
+		extra   string
+		k       string
+		r       string
+		s       string
+	}{
+		{
+			key:     "1",
+			message: "Satoshi Nakamoto",
+			extra:   counter1,
+			k:       "b8e91d19741f580eb14a4489493c085b7618caabcd0220cb0ac29161d9ce38a3",
+			r:       "3311d51d1326e30774b2fb1fbfd5e199ebccb43be1db2ce41051eb2d75e4b68f",
+			s:       "44d2ea67486df31a242363de1f835d583620fea148ee422c8c80b904b53f5ac3",
+		},
+		{
+			// zero extra data is still appended and changes the nonce
+			key:     "1",
+			message: "Satoshi Nakamoto",
+			extra:   "0000000000000000000000000000000000000000000000000000000000000000",
+			k:       "c2d46cf83bd97a7f7f56ee7cb455ee32144bbe55ccd6a396841cf8fad25c4edf",
+			r:       "e9b9772c96fa631dd74baa0da145c46012112b1dbd5d81600fc9f9905b946cbb",
+			s:       "000d08f7541213737694648cf81d77373a9e55632d3f6eb1826194adcb596707",
+		},
+		{
+			key:     "f8b8af8ce3c7cca5e300d33939540c10d45ce001b8f252bfbc57ba0342904181",
+			message: "Alan Turing",
+			extra:   "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			k:       "85b06339ad6f44f29abd896bb8a10fb2cb00aee9eba98519006cd009117544da",
+			r:       "a74d2c432c254f22df0319aa87f947ec907acabea6446545788b40322da38c56",
+			s:       "511786685c12203b03d01b4496bc63d478f817a7dee8a6c0254657e5fb6c9b8f",
+		},
+	}
+
+	for _, test := range tests {
+		keyInt, _ := new(big.Int).SetString(test.key, 16)
+		scalar, _ := secp256k1.NewScalar(keyInt)
+		key, err := secp256k1.NewPrivateKey(scalar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hash := sha256.Sum256([]byte(test.message))
+		var extra [32]byte
+		extraBytes, _ := hex.DecodeString(test.extra)
+		copy(extra[:], extraBytes)
+
+		var e secp256k1.Scalar
+		e.SetByteSlice(hash[:])
+		eBytes := e.Bytes()
+		k := newRFC6979(key.SecretKey, &eBytes, &extra).nonce().Bytes()
+		if hex.EncodeToString(k[:]) != test.k {
+			t.Fatalf("expected k '%v' but got '%x'", test.k, k)
+		}
+
+		sig, err := SignWithEntropy(key, hash[:], extra)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := sig.r.Bytes()
+		if hex.EncodeToString(r[:]) != test.r {
+			t.Fatalf("expected r '%v' but got '%x'", test.r, r)
+		}
+		s := sig.s.Bytes()
+		if hex.EncodeToString(s[:]) != test.s {
+			t.Fatalf("expected s '%v' but got '%x'", test.s, s)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+// Package ellswift implements the ElligatorSwift encoding of public keys
+// and the x-only ECDH used by BIP-324
+// (https://github.com/bitcoin/bips/blob/master/bip-0324.mediawiki).
+//
+// A public key is encoded as 64 bytes (u, t) which are indistinguishable
+// from random. Decoding maps any 64 bytes to a valid point so there is no
+// way to tell an encoding apart from random data.
+package ellswift
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/elnosh/secp256k1"
+)
+
+var ErrInvalidKey = errors.New("invalid key")
+
+var (
+	// sqrt(-3), the root returned by FieldElement.Sqrt
+	minus3Sqrt = fieldFromHex("0a2d2ba93507f1df233770c2a797962cc61f6d15da14ecd47d8d27ae1cd5f852")
+	// 1/2
+	half = fieldFromHex("7fffffffffffffffffffffffffffffffffffffffffffffffffffffff7ffffe18")
+)
+
+func fieldFromHex(s string) *secp256k1.FieldElement {
+	n, _ := new(big.Int).SetString(s, 16)
+	return secp256k1.NewFieldElement(n)
+}
+
+// Encode returns an ElligatorSwift encoding of pub. There are many
+// encodings for each key, one is picked at random so the output is
+// uniformly distributed.
+func Encode(pub *secp256k1.PublicKey) ([64]byte, error) {
+	return encode(pub, rand.Reader)
+}
+
+func encode(pub *secp256k1.PublicKey, random io.Reader) ([64]byte, error) {
+	var out [64]byte
+	if err := pub.Validate(); err != nil {
+		return out, err
+	}
+
+	// pick random u and a random case until the inverse exists. About
+	// a quarter of the tries succeed
+	var buf [33]byte
+	for {
+		if _, err := io.ReadFull(random, buf[:]); err != nil {
+			return out, err
+		}
+
+		var uBytes [32]byte
+		copy(uBytes[:], buf[:32])
+		var u secp256k1.FieldElement
+		if u.SetBytes(&uBytes) || u.IsZero() {
+			// keep u uniform in [1, p)
+			continue
+		}
+
+		t, ok := xswiftecInv(&pub.X, &u, int(buf[32]&7))
+		if !ok {
+			continue
+		}
+
+		// decoding picks the y with the same parity as t
+		if t.IsOdd() != pub.Y.IsOdd() {
+			t.Negate(t)
+		}
+
+		tBytes := t.Bytes()
+		copy(out[:32], uBytes[:])
+		copy(out[32:], tBytes[:])
+		return out, nil
+	}
+}
+
+// Decode returns the public key encoded in b. Every 64-byte string is a
+// valid encoding. The y coordinate of the point has the parity of t
+func Decode(b [64]byte) *secp256k1.PublicKey {
+	u, t := splitEncoding(&b)
+	x := xswiftec(u, t)
+
+	// xswiftec always returns a valid x so the square root exists
+	var y secp256k1.FieldElement
+	y.Square(x).Mult(&y, x).Add(&y, secp256k1.Curve.B)
+	y.Sqrt(&y)
+	if y.IsOdd() != t.IsOdd() {
+		y.Negate(&y)
+	}
+
+	point := &secp256k1.Point{X: *x, Y: y, InfinityPoint: false}
+	return &secp256k1.PublicKey{Point: point}
+}
+
+// XSwiftECDH computes the BIP-324 shared secret between the parties with
+// ElligatorSwift encoded keys ellA and ellB, where A is the party that
+// initiated the connection. privateKey is the key of the caller, which is
+// A if initiating is set and B otherwise. The secret is
+// hash_bip324_ellswift_xonly_ecdh(ellA || ellB || x(d * theirs))
+func XSwiftECDH(privateKey *secp256k1.PrivateKey, ellA, ellB [64]byte, initiating bool) ([32]byte, error) {
+	var secret [32]byte
+	if privateKey.SecretKey.IsZero() {
+		return secret, ErrInvalidKey
+	}
+
+	theirs := ellB
+	if !initiating {
+		theirs = ellA
+	}
+	shared := secp256k1.ScalarMult(privateKey.SecretKey, Decode(theirs).Point)
+	if shared.InfinityPoint {
+		return secret, secp256k1.ErrPointAtInfinity
+	}
+
+	xBytes := shared.X.Bytes()
+	h := taggedHash("bip324_ellswift_xonly_ecdh")
+	h.Write(ellA[:])
+	h.Write(ellB[:])
+	h.Write(xBytes[:])
+	copy(secret[:], h.Sum(nil))
+	return secret, nil
+}
+
+func taggedHash(tag string) hash.Hash {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	return h
+}
+
+// splitEncoding returns u and t reduced modulo p
+func splitEncoding(b *[64]byte) (u, t *secp256k1.FieldElement) {
+	var uBytes, tBytes [32]byte
+	copy(uBytes[:], b[:32])
+	copy(tBytes[:], b[32:])
+	u = new(secp256k1.FieldElement)
+	t = new(secp256k1.FieldElement)
+	u.SetBytes(&uBytes)
+	t.SetBytes(&tBytes)
+	return u, t
+}
+
+// isValidX reports whether x^3 + 7 is a square, ie there is a point with
+// x-coordinate x
+func isValidX(x *secp256k1.FieldElement) bool {
+	var c secp256k1.FieldElement
+	c.Square(x).Mult(&c, x).Add(&c, secp256k1.Curve.B)
+	return c.IsSquare()
+}
+
+// xswiftec maps (u, t) to a valid x-coordinate, the XSwiftEC function
+// from the ElligatorSwift paper (https://eprint.iacr.org/2022/759)
+func xswiftec(u, t *secp256k1.FieldElement) *secp256k1.FieldElement {
+	var one, uu, tt secp256k1.FieldElement
+	one.SetInt(1)
+	uu.Set(u)
+	tt.Set(t)
+
+	// the zero inputs and u^3 + t^2 + 7 = 0 would divide by zero
+	if uu.IsZero() {
+		uu.Set(&one)
+	}
+	if tt.IsZero() {
+		tt.Set(&one)
+	}
+	var u3b, t2 secp256k1.FieldElement
+	u3b.Square(&uu).Mult(&u3b, &uu).Add(&u3b, secp256k1.Curve.B)
+	t2.Square(&tt)
+	if new(secp256k1.FieldElement).Add(&u3b, &t2).IsZero() {
+		tt.Add(&tt, &tt)
+		t2.Square(&tt)
+	}
+
+	// X = (u^3 + 7 - t^2) / (2t), Y = (X + t) / (sqrt(-3) * u)
+	var X, Y, d secp256k1.FieldElement
+	X.Sub(&u3b, &t2).Div(&X, d.Add(&tt, &tt))
+	Y.Add(&X, &tt).Div(&Y, d.Mult(minus3Sqrt, &uu))
+
+	// the first of u + 4Y^2, (-X/Y - u)/2 and (X/Y - u)/2 on the curve
+	var x secp256k1.FieldElement
+	x.Square(&Y)
+	x.Add(&x, &x).Add(&x, &x).Add(&x, &uu)
+	if isValidX(&x) {
+		return &x
+	}
+
+	var xy secp256k1.FieldElement
+	xy.Div(&X, &Y)
+
+	x.Negate(&xy).Sub(&x, &uu).Mult(&x, half)
+	if isValidX(&x) {
+		return &x
+	}
+	x.Sub(&xy, &uu).Mult(&x, half)
+	return &x
+}
+
+// xswiftecInv finds t such that xswiftec(u, t) = x. There are up to 8
+// solutions which are selected with c in [0, 8), it returns false if
+// the selected one does not exist
+func xswiftecInv(x, u *secp256k1.FieldElement, c int) (*secp256k1.FieldElement, bool) {
+	var s, v, g secp256k1.FieldElement
+
+	// g = u^3 + 7
+	g.Square(u).Mult(&g, u).Add(&g, secp256k1.Curve.B)
+
+	if c&2 == 0 {
+		// x would not be the first valid candidate if -x - u is on the curve
+		var m secp256k1.FieldElement
+		m.Negate(x).Sub(&m, u)
+		if isValidX(&m) {
+			return nil, false
+		}
+
+		// s = -(u^3 + 7) / (u^2 + u*x + x^2)
+		var den, t secp256k1.FieldElement
+		den.Square(u)
+		t.Mult(u, x)
+		den.Add(&den, &t)
+		t.Square(x)
+		den.Add(&den, &t)
+		v.Set(x)
+		s.Negate(&g).Div(&s, &den)
+	} else {
+		s.Sub(x, u)
+		if s.IsZero() {
+			return nil, false
+		}
+
+		// r = sqrt(-s * (4(u^3 + 7) + 3 * s * u^2))
+		var r, t secp256k1.FieldElement
+		r.Add(&g, &g).Add(&r, &r)
+		t.Square(u).Mult(&t, &s)
+		r.Add(&r, &t).Add(&r, &t).Add(&r, &t)
+		r.Mult(&r, &s).Negate(&r)
+		if !r.Sqrt(&r) {
+			return nil, false
+		}
+		if c&1 == 1 && r.IsZero() {
+			return nil, false
+		}
+
+		// v = (r/s - u) / 2
+		v.Div(&r, &s).Sub(&v, u).Mult(&v, half)
+	}
+
+	var w secp256k1.FieldElement
+	if !w.Sqrt(&s) {
+		return nil, false
+	}
+
+	// t = ±w * (u * (1 ± sqrt(-3)) / 2 + v)
+	var one, k secp256k1.FieldElement
+	one.SetInt(1)
+	if c&1 == 0 {
+		k.Sub(&one, minus3Sqrt)
+	} else {
+		k.Add(&one, minus3Sqrt)
+	}
+	k.Mult(&k, u).Mult(&k, half).Add(&k, &v)
+
+	t := new(secp256k1.FieldElement).Mult(&w, &k)
+	// cases 0 and 5 use -w
+	if c&5 == 0 || c&5 == 5 {
+		t.Negate(t)
+	}
+	return t, true
+}
@@ -0,0 +1,413 @@
+package ellswift
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/elnosh/secp256k1"
+)
+
+func TestDecode(t *testing.T) {
+	// the expected values come from an independent implementation of the
+	// reference code in BIP-324, the test vector files of the BIP were not
+	// available when these were written
+	decodeTests := []struct {
+		enc string
+		x   string
+		odd bool
+	}{
+		// u = p, t = 0, candidate (-X/Y - u)/2
+		{
+			"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f" +
+				"0000000000000000000000000000000000000000000000000000000000000000",
+			"edd1fd3e327ce90cc7a3542614289aee9682003e9cf7dcc9cf2ca9743be5aa0c",
+			false,
+		},
+		// u = 0, t = p + 5, candidate (X/Y - u)/2
+		{
+			"0000000000000000000000000000000000000000000000000000000000000000" +
+				"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc34",
+			"5e5936b181db0b658e33a8c61aa687dd31d11e1585e356646b4c2071cde7e942",
+			true,
+		},
+		// t = 0, candidate u + 4Y^2
+		{
+			"177743ca78937308b729ed18f795c827dbbfa6dfb76691142b15e2da971029da" +
+				"0000000000000000000000000000000000000000000000000000000000000000",
+			"f529b791f80452e601a4eab8a77a49c54771b4006761cc43b33de19ebc590226",
+			false,
+		},
+		// u^3 + t^2 + 7 = 0, candidate u + 4Y^2
+		{
+			"8d3e42890c677d9d7d48a64aa933ea19295d70e7d912dfab416e666eb7839fb0" +
+				"f30b780eeea9f1d5fdf99171dbea9fdf5421fcd5ddc5aa25efe81b399fc000a0",
+			"72f57f1c32fbc28b72aff842fda0baf0fd38de308ab23a036998fcfb17c9ffe5",
+			false,
+		},
+		// candidate (X/Y - u)/2
+		{
+			"9ac94e59c5475aed0d9caa219d3a52105a596685f0e5b5517fe484a0032caee7" +
+				"63fc80e60239c81834057e30cc800ebe6e5a94b8c3d92f8a4a57a79dd2f71e18",
+			"ef5973d977c1053e973083de1d2bf413c493b5dc245e38dd617314aa075df39c",
+			false,
+		},
+		// candidate (-X/Y - u)/2
+		{
+			"665cb528556771b271b0faac08ebb9eca16d588a54ebe53b07e1b552b3165f16" +
+				"dbc3d1ab504453c0cdc680a1be5c778dce7836943df16be2083c34582c556527",
+			"9a99cd68ded582b895f5df63219566031abb647d5774d1090ad4e86a1b44a5bb",
+			true,
+		},
+		// candidate u + 4Y^2
+		{
+			"28854da342c16b2ff3ad732828a1bdcd0ad3f8ba8b906266449308e3fe85593b" +
+				"989e55979894a27a7ef122d5c4dc91fc51936b37fd08e22ba7c2f630daa77b64",
+			"79931bc8a01345318be127b3ab009b92ef18f0447d600cdc2bb106386a437761",
+			false,
+		},
+		// candidate (-X/Y - u)/2
+		{
+			"61fd5bfbc27c3d9f55c723224c2158944b8aa5cc206b950c41a0f164a3a89a4d" +
+				"745ca3680f7cf12752eef6c11636b54b9ccb3775160bcd87e27bb9133c642baf",
+			"e14cc4bdbd15490afa32096fb90c4b3f1cade6b068483d716cd1343a97b51be7",
+			true,
+		},
+		// candidate u + 4Y^2
+		{
+			"771d79b7a200d9dc37fb794648e14a4458a9fa0fd9983a1ecdaf8cc82279e5c2" +
+				"b8262932dc343df6b83881e80981a42cab2d3d6fdc37e45b9dac4424252abd49",
+			"4a29a58c6c1b86f3bd0777b67bd4c77ee200385ab3545ad570dcd427858629c5",
+			true,
+		},
+		// candidate u + 4Y^2
+		{
+			"0ba09079cab7a995eadf0bd3a0e7bd3c6bed9879f5cc5e985a6f952c7cff6e9f" +
+				"f3630ce7cb511527b4eabc99985039f33e46d3eb279b4b2abae66c712aeec02b",
+			"c59ee80d49af6d0e40cc98fe695a7b8649f11a3e9d7057188310c2e5847bab3a",
+			true,
+		},
+	}
+
+	for _, test := range decodeTests {
+		var b [64]byte
+		encBytes, _ := hex.DecodeString(test.enc)
+		copy(b[:], encBytes)
+
+		pub := Decode(b)
+		x := pub.X.Bytes()
+		if hex.EncodeToString(x[:]) != test.x {
+			t.Fatalf("expected '%v' but got '%x'", test.x, x)
+		}
+		if pub.Y.IsOdd() != test.odd {
+			t.Fatalf("expected odd y '%v' but got '%v'", test.odd, pub.Y.IsOdd())
+		}
+		if err := pub.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// from the BIP-324 ellswift decoding test vectors
+	var zero [64]byte
+	expected := "edd1fd3e327ce90cc7a3542614289aee9682003e9cf7dcc9cf2ca9743be5aa0c"
+	pub := Decode(zero)
+	x := pub.X.Bytes()
+	if hex.EncodeToString(x[:]) != expected {
+		t.Fatalf("expected '%v' but got '%x'", expected, x)
+	}
+
+	// u and t are reduced modulo p so u = p decodes like u = 0
+	var b [64]byte
+	pBytes := secp256k1.Curve.P.FillBytes(make([]byte, 32))
+	copy(b[:32], pBytes)
+	if !Decode(b).Equal(pub.Point) {
+		t.Fatal("expected u = p to decode like u = 0")
+	}
+
+	// random looking inputs always decode to points on the curve
+	for i := 0; i < 64; i++ {
+		var b [64]byte
+		for j := range b {
+			b[j] = byte(i*131 + j*7)
+		}
+		if err := Decode(b).Validate(); err != nil {
+			t.Fatalf("decoding %x: %v", b, err)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		key, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		enc, err := Encode(key.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !Decode(enc).Equal(key.PublicKey.Point) {
+			t.Fatal("decoded key does not match")
+		}
+
+		// a second encoding of the same key is different
+		enc2, err := Encode(key.PublicKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(enc[:], enc2[:]) {
+			t.Fatal("expected a different encoding")
+		}
+	}
+
+	if _, err := Encode(&secp256k1.PublicKey{Point: &secp256k1.Point{InfinityPoint: true}}); err == nil {
+		t.Fatal("expected error encoding the point at infinity")
+	}
+}
+
+func TestXSwiftECInv(t *testing.T) {
+	// the expected values come from an independent implementation of the
+	// reference code in BIP-324, the test vector files of the BIP were not
+	// available when these were written
+	invTests := []struct {
+		u string
+		x string
+		// expected t for each case, empty if there is no solution
+		t [8]string
+	}{
+		{
+			"4c2325bb796eb907eac1b96c0b37fb6b0d0e140220de588195a48c74b748b9ca",
+			"29fa61658dfbf2cadda91650a29d37e18e579a8803103ccf359c7b987120303c",
+			[8]string{
+				"",
+				"",
+				"b8ad1f50a51435807902ff326f3e2e335f7f25f415fcdfe291592ae02da63e86",
+				"5e6f12d2e4ffbf13d687b90b9f14ae10ba7dcf39fa4c30789715fdf7a48f97d8",
+				"",
+				"",
+				"4752e0af5aebca7f86fd00cd90c1d1cca080da0bea03201d6ea6d51ed259bda9",
+				"a190ed2d1b0040ec297846f460eb51ef458230c605b3cf8768ea02075b706457",
+			},
+		},
+		{
+			"b24e488684767bc7fbe2a23d83008340afb209af6a6a881abd7df142199413fd",
+			"e5a5d57e84413337136a110c6137c33ac846a3e47f3c6f331d5f59295739428e",
+			[8]string{
+				"a2868c6e65f86088c5fba0f1e4f7b5e5ff099151f36eb46c454f6f1c71b9003b",
+				"2ae1ac9762ff98cf34077f9083d5bdd164aeb18698c1280df04c704736310a73",
+				"",
+				"",
+				"5d7973919a079f773a045f0e1b084a1a00f66eae0c914b93bab090e28e46fbf4",
+				"d51e53689d006730cbf8806f7c2a422e9b514e79673ed7f20fb38fb7c9cef1bc",
+				"",
+				"",
+			},
+		},
+		{
+			"c88090099f8dc8ab19c5daede0f58b6f91dc8055b37148e5b5ec2cca74b73835",
+			"000388153f2f05d78bf4004fb2e96dded9d15609fe1436c619abb5fb196835fe",
+			[8]string{
+				"",
+				"",
+				"",
+				"",
+				"",
+				"",
+				"",
+				"",
+			},
+		},
+		{
+			"064a751b885f722a89a59fba5103b631aef293ca0bb86814cba19f8e95c1f3bd",
+			"d48c82097fbf395da25c08b9ede341ed4d55917bf4f89073bcee1803a5d0423a",
+			[8]string{
+				"b7e4e393e2e306c115ae62cb75590bccff8d3656457fde8e32f8c63d1a7f3485",
+				"ec18989b871e115a8d30a5c6d57a5c97847d21233a74f1b57e1053f8c699ce1e",
+				"e8f62c78050917a24ed675c87af62f9b5b16bcde73e34eda38aa3c1e4055e9cc",
+				"d09bea5eb05619caaaec2f43bfe23184b8da6a6ecbb234b67184e7508e4eee3c",
+				"481b1c6c1d1cf93eea519d348aa6f4330072c9a9ba802171cd0739c1e580c7aa",
+				"13e7676478e1eea572cf5a392a85a3687b82dedcc58b0e4a81efac0639662e11",
+				"1709d387faf6e85db1298a378509d064a4e943218c1cb125c755c3e0bfaa1263",
+				"2f6415a14fa9e6355513d0bc401dce7b47259591344dcb498e7b18ae71b10df3",
+			},
+		},
+		{
+			"f4ba831389c5172310b2a715b465fa8da1f77c75b47476675aa83ab71fc7e15c",
+			"425140aa8dd7d393e3545e915fef2b0b66895fcf5bd7a3df3b9cd3ba6934c834",
+			[8]string{
+				"",
+				"",
+				"c8b4f7eec4404fec5cf28b1dd4391894de8b1ebf3f72149ad469ae0b3aa5472e",
+				"d674aafac1c422427785295f99a15caa89a9004980ab55124964c93fe6d79a91",
+				"",
+				"",
+				"374b08113bbfb013a30d74e22bc6e76b2174e140c08deb652b9651f3c55ab501",
+				"298b55053e3bddbd887ad6a0665ea3557656ffb67f54aaedb69b36bf1928619e",
+			},
+		},
+	}
+
+	for _, test := range invTests {
+		u, x := fieldFromHex(test.u), fieldFromHex(test.x)
+		for c := 0; c < 8; c++ {
+			tt, ok := xswiftecInv(x, u, c)
+			if ok != (test.t[c] != "") {
+				t.Fatalf("case %v: expected solution '%v' but got '%v'", c, test.t[c] != "", ok)
+			}
+			if !ok {
+				continue
+			}
+			tBytes := tt.Bytes()
+			if hex.EncodeToString(tBytes[:]) != test.t[c] {
+				t.Fatalf("case %v: expected '%v' but got '%x'", c, test.t[c], tBytes)
+			}
+			if !xswiftec(u, tt).Equal(x) {
+				t.Fatalf("case %v does not invert", c)
+			}
+		}
+	}
+
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := &key.PublicKey.X
+
+	found := 0
+	for i := uint64(1); i < 20; i++ {
+		u := new(secp256k1.FieldElement).SetInt(i * 0x1234567)
+		for c := 0; c < 8; c++ {
+			tt, ok := xswiftecInv(x, u, c)
+			if !ok {
+				continue
+			}
+			found++
+			if !xswiftec(u, tt).Equal(x) {
+				t.Fatalf("case %v with u = %v does not invert", c, i)
+			}
+		}
+	}
+	if found == 0 {
+		t.Fatal("expected some inverses")
+	}
+}
+
+func TestXSwiftECDH(t *testing.T) {
+	// the expected values come from an independent implementation of the
+	// reference code in BIP-324, the test vector files of the BIP were not
+	// available when these were written
+	ecdhTests := []struct {
+		priv       string
+		ours       string
+		theirs     string
+		initiating bool
+		secret     string
+	}{
+		{
+			"08d95d2d55bb28d394cfcdf3f87cc7d1ff5df37d98612f568e7a6d26f0fc802c",
+			"6e0a24790cab3b41373854dde98685c679fee989ad21107f7362a430023ffec2" +
+				"cdd4c150b22dae3ab94c96e7a41ee76812697a10fe8d0d478af5daceeb3d1ebd",
+			"a09f60cdd351c4af62aeafbdbd0964a29937024f687cbec2c6896978926fb60a" +
+				"e74a08536dff02ab0dea163f6895718ebbad1267f9d0695f3d5e6a521f3d5d75",
+			true,
+			"efd750d5bd0773cb015f80655f5fad0ee2e354a9a8a871285b316f60e9d093de",
+		},
+		{
+			"07568e8bac8a87a1003b97366905a3909b522b73d5874e9c68f697c36dc72826",
+			"11df8d95d028ad9f305fd6e0748e9c3e345c13bb330b531cc20182295103b5e1" +
+				"9c2c5502c03a3665d26003f17271c4e50d0b9cf2b8ad3a0de0d48a003e8715a4",
+			"c9cf2ab6722618786d2d4d09ade452b8005df08e447dcf55cd9ba266bbc0c516" +
+				"cd9e1086e776fa47e6d805e06091e6f62d9770804f650c9c3f63dc4a59d24adb",
+			false,
+			"ea15c5e4f151dd79f25414898f67ada439e364d2e8c52a130c3ef873482ef776",
+		},
+		{
+			"b6795f96925afe97d74668b399175d9e2883b2c66d18623c6e9860bebc26bf9e",
+			"cb950a799edf592fab8147c9f5aa488176a39c7938098e98c78fd0af0cf772f9" +
+				"b02e2c7c0038b1e8dcc46c7f296cf8198de614ce43a9805b153df45dd2fe7d3e",
+			"3043e5b839c0ac048da8c08be002aa8ab8f5525e557cba24a4c9cea283337979" +
+				"106bdaaa8f407bca461df012e373ebecf1b268edee221929613ff119585d4392",
+			true,
+			"099b2211755e0f4a9d82be469504371c03c89709b4513a44cda0be9038886789",
+		},
+		{
+			"e8fc9d648baf015e16cbb923bdf334006aa236b62c1ce704027b473eae37c4d2",
+			"f8311e45d04c6285dc73061fe5ad738f51b5281ff99a127ddd94d3dcfeda5b94" +
+				"53fb6797795da8fea8d038e5872b2e12843326169a6e170b81920acaab371401",
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" +
+				"ea2c9aa84a39c3e74fdfe6e4728a8dc66b6e3571bef1c94737b7e4be359e618b",
+			false,
+			"707a648d684907a098468f4fa66ff6bb34923088d633d69ee1ffc4deebee661b",
+		},
+	}
+
+	for _, test := range ecdhTests {
+		privBytes, _ := hex.DecodeString(test.priv)
+		priv, err := secp256k1.PrivateKeyFromBytes(privBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ours, theirs [64]byte
+		oursBytes, _ := hex.DecodeString(test.ours)
+		theirsBytes, _ := hex.DecodeString(test.theirs)
+		copy(ours[:], oursBytes)
+		copy(theirs[:], theirsBytes)
+		if !Decode(ours).Equal(priv.PublicKey.Point) {
+			t.Fatal("expected the encoding to decode to the private key's public key")
+		}
+
+		ellA, ellB := ours, theirs
+		if !test.initiating {
+			ellA, ellB = theirs, ours
+		}
+		secret, err := XSwiftECDH(priv, ellA, ellB, test.initiating)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(secret[:]) != test.secret {
+			t.Fatalf("expected '%v' but got '%x'", test.secret, secret)
+		}
+	}
+
+	alice, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bob, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ellA, err := Encode(alice.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ellB, err := Encode(bob.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// alice initiates
+	secretA, err := XSwiftECDH(alice, ellA, ellB, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretB, err := XSwiftECDH(bob, ellA, ellB, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secretA != secretB {
+		t.Fatalf("shared secrets do not match: '%x' and '%x'", secretA, secretB)
+	}
+
+	// the roles are part of the hash
+	swapped, err := XSwiftECDH(bob, ellB, ellA, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swapped == secretA {
+		t.Fatal("expected a different secret with the roles swapped")
+	}
+}
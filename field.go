@@ -0,0 +1,422 @@
+package secp256k1
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// FieldElement is an element of the field of integers modulo p.
+// The value is stored as 4 64-bit limbs in little-endian order
+// and is always kept fully reduced in [0, p).
+// Methods set the receiver to the result and return it. The
+// receiver may alias any of the arguments.
+type FieldElement struct {
+	n [4]uint64
+}
+
+// p = 2^256 - 2^32 - 977
+var fieldPrime = [4]uint64{0xFFFFFFFEFFFFFC2F, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF, 0xFFFFFFFFFFFFFFFF}
+
+// 2^256 mod p. Used to fold the high half of products back into the low half
+const fieldC = 0x1000003D1
+
+var fieldPrimeInt = limbsToBigInt(&fieldPrime)
+
+// NewFieldElement returns n reduced modulo p
+func NewFieldElement(n *big.Int) *FieldElement {
+	v := new(big.Int).Mod(n, fieldPrimeInt)
+	var b [32]byte
+	v.FillBytes(b[:])
+	fe := new(FieldElement)
+	fe.SetBytes(&b)
+	return fe
+}
+
+func (fe *FieldElement) Set(x *FieldElement) *FieldElement {
+	fe.n = x.n
+	return fe
+}
+
+func (fe *FieldElement) SetInt(v uint64) *FieldElement {
+	fe.n = [4]uint64{v, 0, 0, 0}
+	return fe
+}
+
+// SetBytes interprets b as a big-endian integer and reduces it modulo p.
+// It returns true if the value was >= p and had to be reduced
+func (fe *FieldElement) SetBytes(b *[32]byte) bool {
+	n := bytesToLimbs(b)
+	r, overflow := subIfGreaterOrEqual(n, &fieldPrime)
+	fe.n = r
+	return overflow
+}
+
+// Bytes returns the 32-byte big-endian encoding of the element
+func (fe *FieldElement) Bytes() [32]byte {
+	return limbsToBytes(&fe.n)
+}
+
+func (fe *FieldElement) BigInt() *big.Int {
+	return limbsToBigInt(&fe.n)
+}
+
+func (fe *FieldElement) IsZero() bool {
+	return (fe.n[0] | fe.n[1] | fe.n[2] | fe.n[3]) == 0
+}
+
+// CMove sets fe to x if flag is 1 and leaves it unchanged if flag
+// is 0. It runs in constant time, flag must be 0 or 1
+func (fe *FieldElement) CMove(x *FieldElement, flag int) *FieldElement {
+	mask := -uint64(flag)
+	for i := range fe.n {
+		fe.n[i] ^= mask & (fe.n[i] ^ x.n[i])
+	}
+	return fe
+}
+
+// CondNegate negates fe if flag is 1 in constant time
+func (fe *FieldElement) CondNegate(flag int) *FieldElement {
+	var neg FieldElement
+	neg.Negate(fe)
+	return fe.CMove(&neg, flag)
+}
+
+func (fe *FieldElement) Add(x, y *FieldElement) *FieldElement {
+	fe.n = addMod(&x.n, &y.n, &fieldPrime)
+	return fe
+}
+
+func (fe *FieldElement) Sub(x, y *FieldElement) *FieldElement {
+	fe.n = subMod(&x.n, &y.n, &fieldPrime)
+	return fe
+}
+
+func (fe *FieldElement) Negate(x *FieldElement) *FieldElement {
+	var zero FieldElement
+	return fe.Sub(&zero, x)
+}
+
+func (fe *FieldElement) Mult(x, y *FieldElement) *FieldElement {
+	t := mul256(&x.n, &y.n)
+	fe.n = fieldReduce(&t)
+	return fe
+}
+
+func (fe *FieldElement) Square(x *FieldElement) *FieldElement {
+	return fe.Mult(x, x)
+}
+
+// Division is done in terms of the multiplicative inverse
+// a / b (mod p) == a (b^-1) (mod p)
+func (fe *FieldElement) Div(x, y *FieldElement) *FieldElement {
+	inverse := new(FieldElement).Inverse(y)
+	return fe.Mult(x, inverse)
+}
+
+// Inverse sets fe to x^-1 using Fermat's little theorem, x^(p-2).
+// The exponentiation uses a fixed addition chain so it runs in
+// constant time. The inverse of 0 is 0.
+func (fe *FieldElement) Inverse(x *FieldElement) *FieldElement {
+	var t FieldElement
+	x2, x22, x223 := fieldPowChain(x)
+
+	// x^(p-2) = x223^(2^23) * x22, ^(2^5) * x, ^(2^3) * x2, ^(2^2) * x
+	t.squareN(&x223, 23).Mult(&t, &x22)
+	t.squareN(&t, 5).Mult(&t, x)
+	t.squareN(&t, 3).Mult(&t, &x2)
+	t.squareN(&t, 2).Mult(&t, x)
+	return fe.Set(&t)
+}
+
+// Sqrt sets fe to a square root of x and reports whether x is a square.
+// Since p = 3 mod 4 the root is x^((p+1)/4), if x is not a square this
+// gives the root of -x instead and false is returned. It runs in
+// constant time
+func (fe *FieldElement) Sqrt(x *FieldElement) bool {
+	var t, check FieldElement
+	x2, x22, x223 := fieldPowChain(x)
+
+	// x^((p+1)/4) = x223^(2^23) * x22, ^(2^6) * x2, ^(2^2)
+	t.squareN(&x223, 23).Mult(&t, &x22)
+	t.squareN(&t, 6).Mult(&t, &x2)
+	t.squareN(&t, 2)
+
+	ok := check.Square(&t).Equal(x)
+	fe.Set(&t)
+	return ok
+}
+
+// IsSquare reports whether fe has a square root. Zero is a square.
+// It uses the Legendre symbol so it is much faster than Sqrt but
+// not constant time
+func (fe *FieldElement) IsSquare() bool {
+	return fe.Legendre() >= 0
+}
+
+// Legendre returns the Legendre symbol (fe/p): 1 if fe is a non-zero
+// square, -1 if it is not a square and 0 if fe is zero. Not constant time
+func (fe *FieldElement) Legendre() int {
+	return jacobi(fe.n, fieldPrime)
+}
+
+func (fe *FieldElement) IsOdd() bool {
+	return fe.n[0]&1 == 1
+}
+
+// BatchInvert replaces every element with its inverse using Montgomery's
+// trick: the product of all the elements is inverted once and each
+// inverse is recovered from it with 3 multiplications. Zero elements are
+// left as zero. The elements must not alias each other.
+func BatchInvert(elems []*FieldElement) {
+	// prefix[i] holds the product of the non-zero elems[:i]
+	prefix := make([]FieldElement, len(elems))
+	var acc FieldElement
+	acc.SetInt(1)
+	for i, e := range elems {
+		prefix[i] = acc
+		if !e.IsZero() {
+			acc.Mult(&acc, e)
+		}
+	}
+
+	// acc = 1/(e0*...*ei), so 1/ei = acc * prefix[i]
+	acc.Inverse(&acc)
+	for i := len(elems) - 1; i >= 0; i-- {
+		e := elems[i]
+		if e.IsZero() {
+			continue
+		}
+		var inv FieldElement
+		inv.Mult(&acc, &prefix[i])
+		acc.Mult(&acc, e)
+		e.Set(&inv)
+	}
+}
+
+// jacobi computes the Jacobi symbol (a/n) for odd n with the binary
+// algorithm, which only needs shifts, subtractions and comparisons:
+//   - (2/n) = -1 when n = 3 or 5 mod 8
+//   - (a/n) = (n/a), negated when a = n = 3 mod 4 (quadratic reciprocity)
+//   - (a/n) = ((a-n)/n)
+func jacobi(a, n [4]uint64) int {
+	t := 1
+	for a[0]|a[1]|a[2]|a[3] != 0 {
+		for a[0]&1 == 0 {
+			a = [4]uint64{a[0]>>1 | a[1]<<63, a[1]>>1 | a[2]<<63, a[2]>>1 | a[3]<<63, a[3] >> 1}
+			if r := n[0] & 7; r == 3 || r == 5 {
+				t = -t
+			}
+		}
+
+		// both odd now, make a >= n and subtract
+		if d, borrow := sub256(&a, &n); borrow == 1 {
+			a, n = n, a
+			if a[0]&3 == 3 && n[0]&3 == 3 {
+				t = -t
+			}
+			a, _ = sub256(&a, &n)
+		} else {
+			a = d
+		}
+	}
+
+	if n == [4]uint64{1, 0, 0, 0} {
+		return t
+	}
+	return 0
+}
+
+// sub256 returns x - y and the borrow out
+func sub256(x, y *[4]uint64) ([4]uint64, uint64) {
+	var r [4]uint64
+	var borrow uint64
+	r[0], borrow = bits.Sub64(x[0], y[0], 0)
+	r[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	r[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	r[3], borrow = bits.Sub64(x[3], y[3], borrow)
+	return r, borrow
+}
+
+// fieldPowChain computes the shared prefix of the addition chains for
+// p-2 and (p+1)/4. xN denotes x^(2^N - 1), ie N consecutive one bits
+func fieldPowChain(x *FieldElement) (x2, x22, x223 FieldElement) {
+	var x3, x6, x9, x11, x44, x88, x176, x220 FieldElement
+	x2.Square(x).Mult(&x2, x)
+	x3.Square(&x2).Mult(&x3, x)
+	x6.squareN(&x3, 3).Mult(&x6, &x3)
+	x9.squareN(&x6, 3).Mult(&x9, &x3)
+	x11.squareN(&x9, 2).Mult(&x11, &x2)
+	x22.squareN(&x11, 11).Mult(&x22, &x11)
+	x44.squareN(&x22, 22).Mult(&x44, &x22)
+	x88.squareN(&x44, 44).Mult(&x88, &x44)
+	x176.squareN(&x88, 88).Mult(&x176, &x88)
+	x220.squareN(&x176, 44).Mult(&x220, &x44)
+	x223.squareN(&x220, 3).Mult(&x223, &x3)
+	return
+}
+
+// squareN sets fe to x^(2^n)
+func (fe *FieldElement) squareN(x *FieldElement, n int) *FieldElement {
+	fe.Set(x)
+	for i := 0; i < n; i++ {
+		fe.Square(fe)
+	}
+	return fe
+}
+
+func (fe *FieldElement) Pow(x *FieldElement, exp *big.Int) *FieldElement {
+	r := new(FieldElement).SetInt(1)
+	base := new(FieldElement).Set(x)
+	for i := exp.BitLen() - 1; i >= 0; i-- {
+		r.Square(r)
+		if exp.Bit(i) == 1 {
+			r.Mult(r, base)
+		}
+	}
+	return fe.Set(r)
+}
+
+func (fe *FieldElement) Equal(e *FieldElement) bool {
+	d := (fe.n[0] ^ e.n[0]) | (fe.n[1] ^ e.n[1]) | (fe.n[2] ^ e.n[2]) | (fe.n[3] ^ e.n[3])
+	return d == 0
+}
+
+// fieldReduce reduces a 512-bit product modulo p
+func fieldReduce(t *[8]uint64) [4]uint64 {
+	// fold the high 256 bits into the low ones: r = lo + hi*c
+	var r [4]uint64
+	var carry, c uint64
+	for i := 0; i < 4; i++ {
+		hi, lo := bits.Mul64(t[4+i], fieldC)
+		lo, c = bits.Add64(lo, t[i], 0)
+		hi += c
+		lo, c = bits.Add64(lo, carry, 0)
+		hi += c
+		r[i] = lo
+		carry = hi
+	}
+
+	// carry is at most 34 bits, fold it once more
+	hi, lo := bits.Mul64(carry, fieldC)
+	r[0], c = bits.Add64(r[0], lo, 0)
+	r[1], c = bits.Add64(r[1], hi, c)
+	r[2], c = bits.Add64(r[2], 0, c)
+	r[3], c = bits.Add64(r[3], 0, c)
+
+	// if that overflowed, r is now tiny and adding c*2^256 mod p
+	// can't overflow again
+	r[0], c = bits.Add64(r[0], fieldC&-c, 0)
+	r[1], c = bits.Add64(r[1], 0, c)
+	r[2], c = bits.Add64(r[2], 0, c)
+	r[3], _ = bits.Add64(r[3], 0, c)
+
+	r, _ = subIfGreaterOrEqual(r, &fieldPrime)
+	return r
+}
+
+// addMod returns x + y mod m for x, y < m in constant time
+func addMod(x, y, m *[4]uint64) [4]uint64 {
+	var s [4]uint64
+	var carry uint64
+	s[0], carry = bits.Add64(x[0], y[0], 0)
+	s[1], carry = bits.Add64(x[1], y[1], carry)
+	s[2], carry = bits.Add64(x[2], y[2], carry)
+	s[3], carry = bits.Add64(x[3], y[3], carry)
+
+	// x + y < 2m so at most one subtraction of m is needed. If the sum
+	// overflowed 2^256 the subtraction will borrow it back
+	var t [4]uint64
+	var borrow uint64
+	t[0], borrow = bits.Sub64(s[0], m[0], 0)
+	t[1], borrow = bits.Sub64(s[1], m[1], borrow)
+	t[2], borrow = bits.Sub64(s[2], m[2], borrow)
+	t[3], borrow = bits.Sub64(s[3], m[3], borrow)
+
+	// keep s only if there was no carry and subtracting m borrowed
+	mask := -(borrow &^ carry)
+	for i := range s {
+		s[i] = (s[i] & mask) | (t[i] &^ mask)
+	}
+	return s
+}
+
+// subMod returns x - y mod m for x, y < m in constant time
+func subMod(x, y, m *[4]uint64) [4]uint64 {
+	var d [4]uint64
+	var borrow uint64
+	d[0], borrow = bits.Sub64(x[0], y[0], 0)
+	d[1], borrow = bits.Sub64(x[1], y[1], borrow)
+	d[2], borrow = bits.Sub64(x[2], y[2], borrow)
+	d[3], borrow = bits.Sub64(x[3], y[3], borrow)
+
+	// add m back if the subtraction went negative
+	mask := -borrow
+	var carry uint64
+	d[0], carry = bits.Add64(d[0], m[0]&mask, 0)
+	d[1], carry = bits.Add64(d[1], m[1]&mask, carry)
+	d[2], carry = bits.Add64(d[2], m[2]&mask, carry)
+	d[3], _ = bits.Add64(d[3], m[3]&mask, carry)
+	return d
+}
+
+// mul256 returns the full 512-bit product of a and b
+func mul256(a, b *[4]uint64) [8]uint64 {
+	var t [8]uint64
+	for i := 0; i < 4; i++ {
+		var carry uint64
+		for j := 0; j < 4; j++ {
+			hi, lo := bits.Mul64(a[i], b[j])
+			var c uint64
+			lo, c = bits.Add64(lo, t[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			t[i+j] = lo
+			carry = hi
+		}
+		t[i+4] = carry
+	}
+	return t
+}
+
+// subIfGreaterOrEqual returns n - m if n >= m and n otherwise, along
+// with whether the subtraction happened. Runs in constant time
+func subIfGreaterOrEqual(n [4]uint64, m *[4]uint64) ([4]uint64, bool) {
+	var t [4]uint64
+	var borrow uint64
+	t[0], borrow = bits.Sub64(n[0], m[0], 0)
+	t[1], borrow = bits.Sub64(n[1], m[1], borrow)
+	t[2], borrow = bits.Sub64(n[2], m[2], borrow)
+	t[3], borrow = bits.Sub64(n[3], m[3], borrow)
+
+	mask := -borrow
+	for i := range n {
+		n[i] = (n[i] & mask) | (t[i] &^ mask)
+	}
+	return n, borrow == 0
+}
+
+func bytesToLimbs(b *[32]byte) [4]uint64 {
+	var n [4]uint64
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			n[3-i] = n[3-i]<<8 | uint64(b[i*8+j])
+		}
+	}
+	return n
+}
+
+func limbsToBytes(n *[4]uint64) [32]byte {
+	var b [32]byte
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 8; j++ {
+			b[i*8+j] = byte(n[3-i] >> (56 - 8*j))
+		}
+	}
+	return b
+}
+
+func limbsToBigInt(n *[4]uint64) *big.Int {
+	b := limbsToBytes(n)
+	return new(big.Int).SetBytes(b[:])
+}
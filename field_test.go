@@ -0,0 +1,156 @@
+package secp256k1
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+func fieldTestValues(t *testing.T) []*big.Int {
+	pMinusOne := new(big.Int).Sub(fieldPrimeInt, big.NewInt(1))
+	highBit := new(big.Int).Lsh(big.NewInt(1), 255)
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), pMinusOne, highBit}
+	for i := 0; i < 50; i++ {
+		r, err := rand.Int(rand.Reader, fieldPrimeInt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, r)
+	}
+	return values
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	values := fieldTestValues(t)
+	p := fieldPrimeInt
+
+	for _, a := range values {
+		for _, b := range values {
+			x := NewFieldElement(a)
+			y := NewFieldElement(b)
+
+			sum := new(big.Int).Add(a, b)
+			if got := new(FieldElement).Add(x, y).BigInt(); got.Cmp(sum.Mod(sum, p)) != 0 {
+				t.Fatalf("%x + %x: expected '%x' but got '%x'", a, b, sum, got)
+			}
+
+			diff := new(big.Int).Sub(a, b)
+			if got := new(FieldElement).Sub(x, y).BigInt(); got.Cmp(diff.Mod(diff, p)) != 0 {
+				t.Fatalf("%x - %x: expected '%x' but got '%x'", a, b, diff, got)
+			}
+
+			prod := new(big.Int).Mul(a, b)
+			if got := new(FieldElement).Mult(x, y).BigInt(); got.Cmp(prod.Mod(prod, p)) != 0 {
+				t.Fatalf("%x * %x: expected '%x' but got '%x'", a, b, prod, got)
+			}
+		}
+	}
+}
+
+func TestFieldInverse(t *testing.T) {
+	for _, a := range fieldTestValues(t) {
+		x := NewFieldElement(a)
+		inv := new(FieldElement).Inverse(x)
+
+		expected := new(big.Int).ModInverse(a, fieldPrimeInt)
+		if expected == nil {
+			expected = new(big.Int)
+		}
+		if inv.BigInt().Cmp(expected) != 0 {
+			t.Fatalf("inverse of %x: expected '%x' but got '%x'", a, expected, inv.BigInt())
+		}
+
+		exp := big.NewInt(12345)
+		pow := new(FieldElement).Pow(x, exp)
+		if pow.BigInt().Cmp(new(big.Int).Exp(a, exp, fieldPrimeInt)) != 0 {
+			t.Fatalf("pow of %x does not match", a)
+		}
+	}
+}
+
+func TestFieldBytes(t *testing.T) {
+	var b [32]byte
+	for i := range b {
+		b[i] = 0xff
+	}
+
+	fe := new(FieldElement)
+	if overflow := fe.SetBytes(&b); !overflow {
+		t.Fatal("expected overflow setting 2^256 - 1")
+	}
+	if fe.BigInt().Cmp(big.NewInt(fieldC-1)) != 0 {
+		t.Fatalf("expected '%x' but got '%x'", fieldC-1, fe.BigInt())
+	}
+
+	for _, a := range fieldTestValues(t) {
+		fe := NewFieldElement(a)
+		b := fe.Bytes()
+		if new(big.Int).SetBytes(b[:]).Cmp(a) != 0 {
+			t.Fatalf("expected '%x' but got '%x'", a, b)
+		}
+		if overflow := new(FieldElement).SetBytes(&b); overflow {
+			t.Fatalf("unexpected overflow for '%x'", a)
+		}
+	}
+
+	neg := NewFieldElement(big.NewInt(-1))
+	if neg.BigInt().Cmp(new(big.Int).Sub(fieldPrimeInt, big.NewInt(1))) != 0 {
+		t.Fatalf("expected -1 to reduce to p-1 but got '%x'", neg.BigInt())
+	}
+}
+
+func TestFieldSqrt(t *testing.T) {
+	for _, a := range fieldTestValues(t) {
+		x := NewFieldElement(a)
+
+		expected := big.Jacobi(a, fieldPrimeInt)
+		if got := x.Legendre(); got != expected {
+			t.Fatalf("legendre of %x: expected '%v' but got '%v'", a, expected, got)
+		}
+
+		root := new(FieldElement)
+		ok := root.Sqrt(x)
+		if ok != (expected >= 0) || x.IsSquare() != ok {
+			t.Fatalf("sqrt of %x: expected '%v' but got '%v'", a, expected >= 0, ok)
+		}
+		if ok && !new(FieldElement).Square(root).Equal(x) {
+			t.Fatalf("sqrt of %x: root does not square back", a)
+		}
+
+		if x.IsOdd() != (a.Bit(0) == 1) {
+			t.Fatalf("parity of %x does not match", a)
+		}
+	}
+
+	// -1 is not a square since p = 3 mod 4
+	minusOne := new(FieldElement).Negate(new(FieldElement).SetInt(1))
+	if minusOne.IsSquare() || minusOne.Legendre() != -1 {
+		t.Fatal("expected -1 to not be a square")
+	}
+}
+
+func TestBatchInvert(t *testing.T) {
+	values := fieldTestValues(t)
+	elems := make([]*FieldElement, len(values))
+	for i, v := range values {
+		elems[i] = NewFieldElement(v)
+	}
+
+	BatchInvert(elems)
+	for i, v := range values {
+		expected := new(big.Int).ModInverse(v, fieldPrimeInt)
+		if expected == nil {
+			expected = new(big.Int)
+		}
+		if got := elems[i].BigInt(); got.Cmp(expected) != 0 {
+			t.Fatalf("inverse of %x: expected '%x' but got '%x'", v, expected, got)
+		}
+	}
+
+	BatchInvert(nil)
+	zero := new(FieldElement)
+	BatchInvert([]*FieldElement{zero})
+	if !zero.IsZero() {
+		t.Fatal("expected zero to stay zero")
+	}
+}
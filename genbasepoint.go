@@ -0,0 +1,59 @@
+//go:build ignore
+
+// This program generates the precomputed tables of multiples of the
+// generator used by BaseScalarMult. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+
+	"github.com/elnosh/secp256k1"
+)
+
+func main() {
+	generate("basepoint_table.go", "!secp256k1_smalltable", 4)
+	generate("basepoint_table_small.go", "secp256k1_smalltable", 2)
+}
+
+// for a window of w bits, window i holds j * 2^(w*i) * G for j in [1, 2^w)
+func generate(filename, buildTag string, w int) {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by genbasepoint.go; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "//go:build %v\n\n", buildTag)
+	fmt.Fprintf(buf, "package secp256k1\n\n")
+	fmt.Fprintf(buf, "const baseTableWindow = %v\n\n", w)
+	fmt.Fprintf(buf, "// x || y of each point in hex, %v windows of %v points\n", 256/w, 1<<w-1)
+	fmt.Fprintf(buf, "const baseTableData = \"\" +\n")
+
+	base := secp256k1.Curve.G.Copy()
+	for i := 0; i < 256/w; i++ {
+		p := base.Copy()
+		for j := 1; j < 1<<w; j++ {
+			x := p.X.Bytes()
+			y := p.Y.Bytes()
+			fmt.Fprintf(buf, "\t\"%x%x\"", x, y)
+			if i == 256/w-1 && j == 1<<w-1 {
+				fmt.Fprintf(buf, "\n")
+			} else {
+				fmt.Fprintf(buf, " +\n")
+			}
+			p = new(secp256k1.Point).Add(p, base)
+		}
+
+		for j := 0; j < w; j++ {
+			base = new(secp256k1.Point).Add(base, base)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,116 @@
+package secp256k1
+
+import "math/bits"
+
+// secp256k1 has an efficiently computable endomorphism: for a cube root of
+// unity beta mod p, (x, y) -> (beta*x, y) equals multiplying the point by
+// lambda, a cube root of unity mod n. The GLV method uses it to split a
+// scalar k into k1 + k2*lambda with k1 and k2 about half the size of k, so
+// k*P = k1*P + k2*(lambda*P) needs half the doublings.
+
+var (
+	endoLambda = Scalar{n: [4]uint64{0xDF02967C1B23BD72, 0x122E22EA20816678, 0xA5261C028812645A, 0x5363AD4CC05C30E0}}
+	endoBeta   = FieldElement{n: [4]uint64{0xC1396C28719501EE, 0x9CF0497512F58995, 0x6E64479EAC3434E9, 0x7AE96A2B657C0710}}
+
+	// -b1 and -b2 from the reduced lattice basis {(a1, b1), (a2, b2)} of
+	// the vectors (x, y) with x + y*lambda = 0 mod n
+	endoMinusB1 = Scalar{n: [4]uint64{0x6F547FA90ABFE4C3, 0xE4437ED6010E8828, 0, 0}}
+	endoMinusB2 = Scalar{n: [4]uint64{0xD765CDA83DB1562C, 0x8A280AC50774346D, 0xFFFFFFFFFFFFFFFE, 0xFFFFFFFFFFFFFFFF}}
+
+	// g1 = round(2^384 * b2 / n), g2 = round(2^384 * -b1 / n)
+	endoG1 = [4]uint64{0xE893209A45DBB031, 0x3DAA8A1471E8CA7F, 0xE86C90E49284EB15, 0x3086D221A7D46BCD}
+	endoG2 = [4]uint64{0x1571B4AE8AC47F71, 0x221208AC9DF506C6, 0x6F547FA90ABFE4C4, 0xE4437ED6010E8828}
+)
+
+// splitScalar returns k1, k2 such that k = k1 + k2*lambda (mod n) where
+// k1 and k2 are either smaller than 2^128 or larger than n - 2^128, ie
+// their absolute value as signed integers fits in 128 bits. It runs in
+// constant time.
+func splitScalar(k *Scalar) (k1, k2 Scalar) {
+	// c1 = round(k*g1 / 2^384), c2 = round(k*g2 / 2^384)
+	c1 := mulShift384(&k.n, &endoG1)
+	c2 := mulShift384(&k.n, &endoG2)
+
+	// k2 = c1*(-b1) + c2*(-b2)
+	var t Scalar
+	k2.Mul(&c1, &endoMinusB1)
+	t.Mul(&c2, &endoMinusB2)
+	k2.Add(&k2, &t)
+
+	// k1 = k - k2*lambda
+	t.Mul(&k2, &endoLambda)
+	k1.Sub(k, &t)
+	return k1, k2
+}
+
+// mulShift384 returns round(k*g / 2^384). The result is less than 2^127
+// since g < 2^254
+func mulShift384(k, g *[4]uint64) Scalar {
+	t := mul256(k, g)
+
+	// round by adding the highest bit that is shifted out
+	var s Scalar
+	var carry uint64
+	s.n[0], carry = bits.Add64(t[6], t[5]>>63, 0)
+	s.n[1], _ = bits.Add64(t[7], 0, carry)
+	return s
+}
+
+// endomorphism returns lambda*p = (beta*x, y) for a point in
+// jacobian coordinates, (beta*X, Y, Z)
+func (p *JacobianPoint) endomorphism(q *JacobianPoint) *JacobianPoint {
+	p.X.Mult(&q.X, &endoBeta)
+	p.Y.Set(&q.Y)
+	p.Z.Set(&q.Z)
+	return p
+}
+
+// a scalar below 2^256 has a NAF of at most 257 digits
+const wnafMaxLen = 257
+
+// wnaf computes the width-w non-adjacent form of k: digits that are
+// either 0 or odd and in (-2^(w-1), 2^(w-1)), with at most one non-zero
+// digit in any w consecutive ones, such that k = sum(naf[i] * 2^i).
+// It returns the digits and the number of digits used. This is not
+// constant time.
+func wnaf(k *Scalar, w uint) ([wnafMaxLen]int8, int) {
+	var naf [wnafMaxLen]int8
+
+	// one extra limb since subtracting a negative digit can carry past 2^256
+	var n [5]uint64
+	copy(n[:], k.n[:])
+
+	length := 0
+	for i := 0; n[0]|n[1]|n[2]|n[3]|n[4] != 0; i++ {
+		if n[0]&1 == 1 {
+			d := int64(n[0] & (1<<w - 1))
+			if d >= 1<<(w-1) {
+				d -= 1 << w
+			}
+			naf[i] = int8(d)
+
+			// n -= d, which clears the low w bits
+			var c uint64
+			if d > 0 {
+				n[0], c = bits.Sub64(n[0], uint64(d), 0)
+				for j := 1; j < 5; j++ {
+					n[j], c = bits.Sub64(n[j], 0, c)
+				}
+			} else {
+				n[0], c = bits.Add64(n[0], uint64(-d), 0)
+				for j := 1; j < 5; j++ {
+					n[j], c = bits.Add64(n[j], 0, c)
+				}
+			}
+		}
+
+		// n >>= 1
+		for j := 0; j < 4; j++ {
+			n[j] = n[j]>>1 | n[j+1]<<63
+		}
+		n[4] >>= 1
+		length = i + 1
+	}
+
+	return naf, length
+}
@@ -0,0 +1,70 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestEndomorphism(t *testing.T) {
+	var g, lg JacobianPoint
+	g.SetAffine(Curve.G)
+	lg.endomorphism(&g)
+
+	if !lg.ToAffine().Equal(ScalarMultVartime(&endoLambda, Curve.G)) {
+		t.Fatal("(beta*x, y) != lambda*G")
+	}
+}
+
+func TestSplitScalar(t *testing.T) {
+	bound := new(big.Int).Lsh(big.NewInt(1), 128)
+	values := scalarTestValues(t)
+	values = append(values, new(big.Int).Sub(Curve.N, bound), new(big.Int).Rsh(Curve.N, 1), bound)
+
+	for _, kInt := range values {
+		k, _ := NewScalar(kInt)
+		k1, k2 := splitScalar(k)
+
+		// k1 + k2*lambda == k
+		sum := new(Scalar).Mul(&k2, &endoLambda)
+		sum.Add(sum, &k1)
+		if !sum.Equal(k) {
+			t.Fatalf("k1 + k2*lambda != k for k = %x", kInt)
+		}
+
+		for _, half := range []Scalar{k1, k2} {
+			abs := half.BigInt()
+			if half.isHigh() == 1 {
+				abs.Sub(Curve.N, abs)
+			}
+			if abs.Cmp(bound) >= 0 {
+				t.Fatalf("split of %x is too large: %x", kInt, abs)
+			}
+		}
+	}
+}
+
+func TestWNAF(t *testing.T) {
+	for _, kInt := range scalarTestValues(t) {
+		k, _ := NewScalar(kInt)
+		naf, length := wnaf(k, wnafWindow)
+
+		sum := new(big.Int)
+		lastNonZero := -wnafWindow
+		for i := length - 1; i >= 0; i-- {
+			d := int(naf[i])
+			if d != 0 {
+				if d%2 == 0 || d >= 1<<(wnafWindow-1) || d <= -(1<<(wnafWindow-1)) {
+					t.Fatalf("invalid digit %v", d)
+				}
+				if lastNonZero-i < wnafWindow && lastNonZero >= 0 {
+					t.Fatalf("non-zero digits too close at %v and %v", lastNonZero, i)
+				}
+				lastNonZero = i
+			}
+			sum.Lsh(sum, 1).Add(sum, big.NewInt(int64(d)))
+		}
+		if sum.Cmp(kInt) != 0 {
+			t.Fatalf("expected '%x' but got '%x'", kInt, sum)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Package hashtocurve implements hashing to secp256k1 as specified in
+// RFC 9380 (https://www.rfc-editor.org/rfc/rfc9380) for the suites
+// secp256k1_XMD:SHA-256_SSWU_RO_ (HashToCurve) and
+// secp256k1_XMD:SHA-256_SSWU_NU_ (EncodeToCurve).
+//
+// secp256k1 has A = 0 so the simplified SWU map cannot be used on it
+// directly. Field elements are instead mapped to the 3-isogenous curve
+// E': y^2 = x^3 + A'x + B' and the result is sent back to secp256k1
+// with the isogeny map.
+package hashtocurve
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/elnosh/secp256k1"
+)
+
+const (
+	// sha256 output and block sizes, b_in_bytes and s_in_bytes in the RFC
+	hashSize  = 32
+	blockSize = 64
+
+	// bytes per field element in hash_to_field, ceil((ceil(log2(p)) + k) / 8) with k = 128
+	fieldLen = 48
+)
+
+var (
+	ErrDSTEmpty      = errors.New("domain separation tag must not be empty")
+	ErrInvalidLength = errors.New("invalid length for expand_message_xmd")
+)
+
+// prefix used to hash domain separation tags longer than 255 bytes
+var oversizeDSTPrefix = []byte("H2C-OVERSIZE-DST-")
+
+// constants of the isogenous curve E' and the map
+var (
+	isoA = fieldFromHex("3f8731abdd661adca08a5558f0f5d272e953d363cb6f0e5d405447c01a444533")
+	isoB = fieldFromHex("6eb")
+	// Z = -11
+	sswuZ = fieldFromHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc24")
+
+	// -B'/A' and B'/(Z*A'), x1 for the regular and exceptional cases of the map
+	minusBOverA = new(secp256k1.FieldElement).Div(new(secp256k1.FieldElement).Negate(isoB), isoA)
+	bOverZA     = new(secp256k1.FieldElement).Div(isoB, new(secp256k1.FieldElement).Mult(sswuZ, isoA))
+)
+
+// coefficients of the 3-isogeny map from E' to secp256k1 in increasing
+// degree. The denominators are monic, the leading 1 is left out
+var (
+	isoXNum = []*secp256k1.FieldElement{
+		fieldFromHex("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa8c7"),
+		fieldFromHex("7d3d4c80bc321d5b9f315cea7fd44c5d595d2fc0bf63b92dfff1044f17c6581"),
+		fieldFromHex("534c328d23f234e6e2a413deca25caece4506144037c40314ecbd0b53d9dd262"),
+		fieldFromHex("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa88c"),
+	}
+	isoXDen = []*secp256k1.FieldElement{
+		fieldFromHex("d35771193d94918a9ca34ccbb7b640dd86cd409542f8487d9fe6b745781eb49b"),
+		fieldFromHex("edadc6f64383dc1df7c4b2d51b54225406d36b641f5e41bbc52a56612a8c6d14"),
+	}
+	isoYNum = []*secp256k1.FieldElement{
+		fieldFromHex("4bda12f684bda12f684bda12f684bda12f684bda12f684bda12f684b8e38e23c"),
+		fieldFromHex("c75e0c32d5cb7c0fa9d0a54b12a0a6d5647ab046d686da6fdffc90fc201d71a3"),
+		fieldFromHex("29a6194691f91a73715209ef6512e576722830a201be2018a765e85a9ecee931"),
+		fieldFromHex("2f684bda12f684bda12f684bda12f684bda12f684bda12f684bda12f38e38d84"),
+	}
+	isoYDen = []*secp256k1.FieldElement{
+		fieldFromHex("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffff93b"),
+		fieldFromHex("7a06534bb8bdb49fd5e9e6632722c2989467c1bfc8e8d978dfb425d2685c2573"),
+		fieldFromHex("6484aa716545ca2cf3a70c3fa8fe337e0a3d21162f0d6299a7bf8192bfd2a76f"),
+	}
+)
+
+func fieldFromHex(s string) *secp256k1.FieldElement {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("hashtocurve: invalid constant " + s)
+	}
+	return secp256k1.NewFieldElement(n)
+}
+
+// HashToCurve hashes msg to a point using the random oracle suite
+// secp256k1_XMD:SHA-256_SSWU_RO_. The output is indistinguishable from a
+// random point and nobody knows its discrete log. dst is the domain
+// separation tag of the application.
+func HashToCurve(msg, dst []byte) (*secp256k1.Point, error) {
+	u, err := HashToField(msg, dst, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	q0 := MapToCurve(&u[0])
+	q1 := MapToCurve(&u[1])
+	// the cofactor of secp256k1 is 1 so there is nothing to clear
+	return new(secp256k1.Point).Add(q0, q1), nil
+}
+
+// EncodeToCurve hashes msg to a point using the nonuniform suite
+// secp256k1_XMD:SHA-256_SSWU_NU_. It is cheaper than HashToCurve but
+// the output only covers about half of the points, so it should only be
+// used when the protocol does not need a random oracle.
+func EncodeToCurve(msg, dst []byte) (*secp256k1.Point, error) {
+	u, err := HashToField(msg, dst, 1)
+	if err != nil {
+		return nil, err
+	}
+	return MapToCurve(&u[0]), nil
+}
+
+// HashToField hashes msg to count field elements with expand_message_xmd
+func HashToField(msg, dst []byte, count int) ([]secp256k1.FieldElement, error) {
+	uniform, err := ExpandMessageXMD(msg, dst, count*fieldLen)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2^256 mod p, to reduce each 48 bytes as hi*2^256 + lo
+	var c secp256k1.FieldElement
+	c.SetInt(0x1000003D1)
+
+	u := make([]secp256k1.FieldElement, count)
+	for i := range u {
+		b := uniform[i*fieldLen : (i+1)*fieldLen]
+
+		var hiBytes, loBytes [32]byte
+		copy(hiBytes[32-(fieldLen-32):], b[:fieldLen-32])
+		copy(loBytes[:], b[fieldLen-32:])
+
+		var hi, lo secp256k1.FieldElement
+		hi.SetBytes(&hiBytes)
+		lo.SetBytes(&loBytes)
+		u[i].Mult(&hi, &c).Add(&u[i], &lo)
+	}
+	return u, nil
+}
+
+// ExpandMessageXMD expands msg to length uniformly random bytes using
+// SHA-256 as described in section 5.3.1 of the RFC. Tags longer than
+// 255 bytes are replaced by their hash as in section 5.3.3.
+func ExpandMessageXMD(msg, dst []byte, length int) ([]byte, error) {
+	if len(dst) == 0 {
+		return nil, ErrDSTEmpty
+	}
+	if len(dst) > 255 {
+		h := sha256.New()
+		h.Write(oversizeDSTPrefix)
+		h.Write(dst)
+		dst = h.Sum(nil)
+	}
+	if length <= 0 || length > 255*hashSize {
+		return nil, ErrInvalidLength
+	}
+
+	// DST_prime = DST || I2OSP(len(DST), 1)
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+	ell := (length + hashSize - 1) / hashSize
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	h := sha256.New()
+	h.Write(make([]byte, blockSize))
+	h.Write(msg)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	out := make([]byte, 0, ell*hashSize)
+	out = append(out, bi...)
+	// b_i = H(strxor(b_0, b_(i-1)) || I2OSP(i, 1) || DST_prime)
+	for i := 2; i <= ell; i++ {
+		x := make([]byte, hashSize)
+		for j := range x {
+			x[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(x)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		out = append(out, bi...)
+	}
+
+	return out[:length], nil
+}
+
+// MapToCurve maps a field element to a point with the simplified SWU map
+// on E' followed by the isogeny map. It runs in constant time except for
+// the point at infinity, which the isogeny only returns for a handful of
+// inputs.
+func MapToCurve(u *secp256k1.FieldElement) *secp256k1.Point {
+	x, y := sswu(u)
+	return isoMap(x, y)
+}
+
+// sswu is the simplified SWU map from section 6.6.2 of the RFC
+func sswu(u *secp256k1.FieldElement) (x, y *secp256k1.FieldElement) {
+	var u2, tv1, tv2, x1, x2, gx1, gx2, y1, y2 secp256k1.FieldElement
+
+	// tv1 = Z^2 * u^4 + Z * u^2
+	u2.Square(u)
+	tv2.Mult(sswuZ, &u2)
+	tv1.Square(&tv2).Add(&tv1, &tv2)
+
+	// x1 = (-B / A) * (1 + 1 / tv1), or B / (Z * A) if tv1 is 0
+	exceptional := boolFlag(tv1.IsZero())
+	x1.Inverse(&tv1).Add(&x1, new(secp256k1.FieldElement).SetInt(1)).Mult(&x1, minusBOverA)
+	x1.CMove(bOverZA, exceptional)
+
+	// x2 = Z * u^2 * x1
+	x2.Mult(&tv2, &x1)
+
+	curveRHS(&gx1, &x1)
+	curveRHS(&gx2, &x2)
+
+	// gx1 or gx2 is always a square, use x1 if it is gx1
+	isSquare := boolFlag(y1.Sqrt(&gx1))
+	y2.Sqrt(&gx2)
+	x2.CMove(&x1, isSquare)
+	y2.CMove(&y1, isSquare)
+
+	// sgn0(y) has to match sgn0(u)
+	y2.CondNegate(boolFlag(u.IsOdd() != y2.IsOdd()))
+	return &x2, &y2
+}
+
+// curveRHS sets r to x^3 + A'x + B'
+func curveRHS(r, x *secp256k1.FieldElement) {
+	var t secp256k1.FieldElement
+	t.Mult(isoA, x)
+	r.Square(x).Mult(r, x).Add(r, &t).Add(r, isoB)
+}
+
+// isoMap sends a point on E' to secp256k1 with the 3-isogeny
+// x = x_num / x_den, y = y' * y_num / y_den
+func isoMap(x, y *secp256k1.FieldElement) *secp256k1.Point {
+	xNum := evalPoly(isoXNum, x, false)
+	xDen := evalPoly(isoXDen, x, true)
+	yNum := evalPoly(isoYNum, x, false)
+	yDen := evalPoly(isoYDen, x, true)
+
+	// invert both denominators at once
+	var d secp256k1.FieldElement
+	d.Mult(xDen, yDen)
+	if d.IsZero() {
+		return &secp256k1.Point{InfinityPoint: true}
+	}
+	d.Inverse(&d)
+
+	p := new(secp256k1.Point)
+	p.X.Mult(xNum, yDen).Mult(&p.X, &d)
+	p.Y.Mult(yNum, xDen).Mult(&p.Y, &d).Mult(&p.Y, y)
+	return p
+}
+
+// evalPoly evaluates the polynomial with coefficients k in increasing
+// degree at x using Horner's method. If monic is set there is an extra
+// leading coefficient of 1
+func evalPoly(k []*secp256k1.FieldElement, x *secp256k1.FieldElement, monic bool) *secp256k1.FieldElement {
+	r := new(secp256k1.FieldElement).Set(k[len(k)-1])
+	if monic {
+		r.Add(r, x)
+	}
+	for i := len(k) - 2; i >= 0; i-- {
+		r.Mult(r, x).Add(r, k[i])
+	}
+	return r
+}
+
+func boolFlag(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
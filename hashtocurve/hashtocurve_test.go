@@ -0,0 +1,128 @@
+package hashtocurve
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/elnosh/secp256k1"
+)
+
+// test vectors from RFC 9380 appendices J.8 and K.1
+
+func TestExpandMessageXMD(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-expander-SHA256-128")
+
+	tests := []struct {
+		msg      string
+		length   int
+		expected string
+	}{
+		{"", 0x20, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", 0x20, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+
+	for _, test := range tests {
+		got, err := ExpandMessageXMD([]byte(test.msg), dst, test.length)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(got) != test.expected {
+			t.Fatalf("expected '%v' but got '%x'", test.expected, got)
+		}
+	}
+
+	// the length is part of the hash input so outputs of
+	// different lengths are unrelated
+	short, _ := ExpandMessageXMD([]byte("abc"), dst, 32)
+	long, _ := ExpandMessageXMD([]byte("abc"), dst, 200)
+	if len(long) != 200 || bytes.Equal(short, long[:32]) {
+		t.Fatal("expected independent outputs for different lengths")
+	}
+
+	if _, err := ExpandMessageXMD(nil, nil, 32); !errors.Is(err, ErrDSTEmpty) {
+		t.Fatalf("expected '%v' but got '%v'", ErrDSTEmpty, err)
+	}
+	if _, err := ExpandMessageXMD(nil, dst, 255*32+1); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("expected '%v' but got '%v'", ErrInvalidLength, err)
+	}
+
+	// tags over 255 bytes are hashed
+	longDST := bytes.Repeat([]byte("a"), 256)
+	h, err := ExpandMessageXMD(nil, longDST, 32)
+	if err != nil || len(h) != 32 {
+		t.Fatalf("expected 32 bytes but got '%x' and '%v'", h, err)
+	}
+}
+
+func TestHashToCurve(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_RO_")
+
+	tests := []struct {
+		msg string
+		x   string
+		y   string
+	}{
+		{
+			"",
+			"c1cae290e291aee617ebaef1be6d73861479c48b841eaba9b7b5852ddfeb1346",
+			"64fa678e07ae116126f08b022a94af6de15985c996c3a91b64c406a960e51067",
+		},
+		{
+			"abc",
+			"3377e01eab42db296b512293120c6cee72b6ecf9f9205760bd9ff11fb3cb2c4b",
+			"7f95890f33efebd1044d382a01b1bee0900fb6116f94688d487c6c7b9c8371f6",
+		},
+		{
+			"abcdef0123456789",
+			"bac54083f293f1fe08e4a70137260aa90783a5cb84d3f35848b324d0674b0e3a",
+			"4436476085d4c3c4508b60fcf4389c40176adce756b398bdee27bca19758d828",
+		},
+	}
+
+	for _, test := range tests {
+		p, err := HashToCurve([]byte(test.msg), dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkPoint(t, p, test.x, test.y)
+	}
+}
+
+func TestEncodeToCurve(t *testing.T) {
+	dst := []byte("QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_NU_")
+
+	p, err := EncodeToCurve([]byte(""), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPoint(t, p,
+		"a4792346075feae77ac3b30026f99c1441b4ecf666ded19b7522cf65c4c55c5b",
+		"62c59e2a6aeed1b23be5883e833912b08ba06be7f57c0e9cdc663f31639ff3a7")
+}
+
+func TestMapToCurve(t *testing.T) {
+	// every input has to land on the curve, including 0 which takes the
+	// exceptional case of the map
+	for i := uint64(0); i < 50; i++ {
+		u := new(secp256k1.FieldElement).SetInt(i)
+		if p := MapToCurve(u); p.Validate() != nil {
+			t.Fatalf("map of %v is not on the curve", i)
+		}
+	}
+}
+
+func checkPoint(t *testing.T, p *secp256k1.Point, x, y string) {
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	px := p.X.Bytes()
+	py := p.Y.Bytes()
+	if hex.EncodeToString(px[:]) != x {
+		t.Fatalf("expected x '%v' but got '%x'", x, px)
+	}
+	if hex.EncodeToString(py[:]) != y {
+		t.Fatalf("expected y '%v' but got '%x'", y, py)
+	}
+}
@@ -0,0 +1,206 @@
+package secp256k1
+
+// JacobianPoint is a point in Jacobian projective coordinates, representing
+// the affine point (X/Z^2, Y/Z^3). Z = 0 is the point at infinity.
+// Working in these coordinates avoids a field inversion on every
+// addition and doubling, only one is needed to convert back to affine.
+type JacobianPoint struct {
+	X FieldElement
+	Y FieldElement
+	Z FieldElement
+}
+
+func (p *JacobianPoint) Set(q *JacobianPoint) *JacobianPoint {
+	*p = *q
+	return p
+}
+
+func (p *JacobianPoint) SetInfinity() *JacobianPoint {
+	p.X.SetInt(0)
+	p.Y.SetInt(0)
+	p.Z.SetInt(0)
+	return p
+}
+
+// SetAffine sets p to the affine point a with Z = 1
+func (p *JacobianPoint) SetAffine(a *Point) *JacobianPoint {
+	if a.InfinityPoint {
+		return p.SetInfinity()
+	}
+	p.X.Set(&a.X)
+	p.Y.Set(&a.Y)
+	p.Z.SetInt(1)
+	return p
+}
+
+func (p *JacobianPoint) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
+// ToAffine converts p back to affine coordinates
+// x = X/Z^2, y = Y/Z^3
+func (p *JacobianPoint) ToAffine() *Point {
+	if p.IsInfinity() {
+		return &Point{InfinityPoint: true}
+	}
+
+	zinv := new(FieldElement).Inverse(&p.Z)
+	zinv2 := new(FieldElement).Square(zinv)
+	zinv3 := new(FieldElement).Mult(zinv2, zinv)
+
+	r := new(Point)
+	r.X.Mult(&p.X, zinv2)
+	r.Y.Mult(&p.Y, zinv3)
+	return r
+}
+
+// Double sets p to 2q.
+// formula dbl-2009-l from https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html
+func (p *JacobianPoint) Double(q *JacobianPoint) *JacobianPoint {
+	if q.IsInfinity() || q.Y.IsZero() {
+		return p.SetInfinity()
+	}
+
+	var a, b, c, d, e, f, t FieldElement
+	// A = X1^2, B = Y1^2, C = B^2
+	a.Square(&q.X)
+	b.Square(&q.Y)
+	c.Square(&b)
+
+	// D = 2*((X1+B)^2-A-C)
+	d.Add(&q.X, &b).Square(&d).Sub(&d, &a).Sub(&d, &c)
+	d.Add(&d, &d)
+
+	// E = 3*A, F = E^2
+	e.Add(&a, &a).Add(&e, &a)
+	f.Square(&e)
+
+	// Z3 = 2*Y1*Z1
+	var x3, y3, z3 FieldElement
+	z3.Mult(&q.Y, &q.Z)
+	z3.Add(&z3, &z3)
+
+	// X3 = F-2*D
+	x3.Sub(&f, &d).Sub(&x3, &d)
+
+	// Y3 = E*(D-X3)-8*C
+	t.Add(&c, &c).Add(&t, &t).Add(&t, &t)
+	y3.Sub(&d, &x3).Mult(&y3, &e).Sub(&y3, &t)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
+
+// Add sets p to p1 + p2
+func (p *JacobianPoint) Add(p1, p2 *JacobianPoint) *JacobianPoint {
+	if p1.IsInfinity() {
+		return p.Set(p2)
+	}
+	if p2.IsInfinity() {
+		return p.Set(p1)
+	}
+
+	r, hZero, rZero := addJacobian(p1, p2)
+	if hZero {
+		// same x-coordinate, either the same point or its negation
+		if rZero {
+			return p.Double(p1)
+		}
+		return p.SetInfinity()
+	}
+	return p.Set(&r)
+}
+
+// addJacobian computes p1 + p2 with the general addition formula, which is
+// only correct when neither point is at infinity and p1 != p2. It also
+// returns whether H and r were zero so the caller can detect p1 == p2
+// (both zero) and p1 == -p2 (only H zero).
+// formula add-2007-bl from https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html
+func addJacobian(p1, p2 *JacobianPoint) (res JacobianPoint, hZero, rZero bool) {
+	var z1z1, z2z2, u1, u2, s1, s2, h, i, j, r, v FieldElement
+	// Z1Z1 = Z1^2, Z2Z2 = Z2^2
+	z1z1.Square(&p1.Z)
+	z2z2.Square(&p2.Z)
+
+	// U1 = X1*Z2Z2, U2 = X2*Z1Z1
+	u1.Mult(&p1.X, &z2z2)
+	u2.Mult(&p2.X, &z1z1)
+
+	// S1 = Y1*Z2*Z2Z2, S2 = Y2*Z1*Z1Z1
+	s1.Mult(&p1.Y, &p2.Z).Mult(&s1, &z2z2)
+	s2.Mult(&p2.Y, &p1.Z).Mult(&s2, &z1z1)
+
+	// H = U2-U1, r = 2*(S2-S1)
+	h.Sub(&u2, &u1)
+	r.Sub(&s2, &s1)
+	hZero = h.IsZero()
+	rZero = r.IsZero()
+	r.Add(&r, &r)
+
+	// I = (2*H)^2, J = H*I, V = U1*I
+	i.Add(&h, &h).Square(&i)
+	j.Mult(&h, &i)
+	v.Mult(&u1, &i)
+
+	// X3 = r^2-J-2*V
+	res.X.Square(&r).Sub(&res.X, &j).Sub(&res.X, &v).Sub(&res.X, &v)
+
+	// Y3 = r*(V-X3)-2*S1*J
+	s1.Mult(&s1, &j)
+	s1.Add(&s1, &s1)
+	res.Y.Sub(&v, &res.X).Mult(&res.Y, &r).Sub(&res.Y, &s1)
+
+	// Z3 = ((Z1+Z2)^2-Z1Z1-Z2Z2)*H
+	res.Z.Add(&p1.Z, &p2.Z).Square(&res.Z).Sub(&res.Z, &z1z1).Sub(&res.Z, &z2z2).Mult(&res.Z, &h)
+	return res, hZero, rZero
+}
+
+// AddMixed sets p to p1 + p2 where p2 is in affine coordinates, which
+// saves several multiplications compared to Add.
+// formula madd-2007-bl from https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian-0.html
+func (p *JacobianPoint) AddMixed(p1 *JacobianPoint, p2 *Point) *JacobianPoint {
+	if p2.InfinityPoint {
+		return p.Set(p1)
+	}
+	if p1.IsInfinity() {
+		return p.SetAffine(p2)
+	}
+
+	var z1z1, u2, s2, h, hh, i, j, r, v FieldElement
+	// Z1Z1 = Z1^2, U2 = X2*Z1Z1, S2 = Y2*Z1*Z1Z1
+	z1z1.Square(&p1.Z)
+	u2.Mult(&p2.X, &z1z1)
+	s2.Mult(&p2.Y, &p1.Z).Mult(&s2, &z1z1)
+
+	// H = U2-X1, r = 2*(S2-Y1)
+	h.Sub(&u2, &p1.X)
+	r.Sub(&s2, &p1.Y)
+	if h.IsZero() {
+		if r.IsZero() {
+			return p.Double(p1)
+		}
+		return p.SetInfinity()
+	}
+	r.Add(&r, &r)
+
+	// HH = H^2, I = 4*HH, J = H*I, V = X1*I
+	hh.Square(&h)
+	i.Add(&hh, &hh).Add(&i, &i)
+	j.Mult(&h, &i)
+	v.Mult(&p1.X, &i)
+
+	var x3, y3, z3, t FieldElement
+	// X3 = r^2-J-2*V
+	x3.Square(&r).Sub(&x3, &j).Sub(&x3, &v).Sub(&x3, &v)
+
+	// Y3 = r*(V-X3)-2*Y1*J
+	t.Mult(&p1.Y, &j)
+	t.Add(&t, &t)
+	y3.Sub(&v, &x3).Mult(&y3, &r).Sub(&y3, &t)
+
+	// Z3 = (Z1+H)^2-Z1Z1-HH
+	z3.Add(&p1.Z, &h).Square(&z3).Sub(&z3, &z1z1).Sub(&z3, &hh)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
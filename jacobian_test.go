@@ -0,0 +1,58 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+func pointFromHex(t *testing.T, x, y string) *Point {
+	xInt, ok := new(big.Int).SetString(x, 16)
+	if !ok {
+		t.Fatalf("invalid hex '%v'", x)
+	}
+	yInt, ok := new(big.Int).SetString(y, 16)
+	if !ok {
+		t.Fatalf("invalid hex '%v'", y)
+	}
+	return &Point{X: *NewFieldElement(xInt), Y: *NewFieldElement(yInt), InfinityPoint: false}
+}
+
+func TestJacobianAddAndDouble(t *testing.T) {
+	g := Curve.G
+	g2 := new(Point).Add(g, g)
+	g3 := new(Point).Add(g2, g)
+	negG := new(Point).Neg(g)
+
+	var jg, jg2, r JacobianPoint
+	jg.SetAffine(g)
+	jg2.Double(&jg)
+
+	tests := []struct {
+		name     string
+		got      *Point
+		expected *Point
+	}{
+		{"double", jg2.ToAffine(), g2},
+		{"add", new(JacobianPoint).Add(&jg2, &jg).ToAffine(), g3},
+		{"add mixed", new(JacobianPoint).AddMixed(&jg2, g).ToAffine(), g3},
+		{"add same point", new(JacobianPoint).Add(&jg, &jg).ToAffine(), g2},
+		{"add mixed same point", new(JacobianPoint).AddMixed(&jg, g).ToAffine(), g2},
+		{"add negation", new(JacobianPoint).AddMixed(&jg, negG).ToAffine(), &Point{InfinityPoint: true}},
+		{"add infinity", new(JacobianPoint).Add(&jg, r.SetInfinity()).ToAffine(), g},
+		{"infinity add", new(JacobianPoint).Add(r.SetInfinity(), &jg).ToAffine(), g},
+		{"double infinity", new(JacobianPoint).Double(r.SetInfinity()).ToAffine(), &Point{InfinityPoint: true}},
+	}
+
+	for _, test := range tests {
+		if !test.got.Equal(test.expected) {
+			t.Fatalf("%v: points do not match", test.name)
+		}
+	}
+
+	// receiver aliasing an argument
+	r.SetAffine(g)
+	r.Add(&r, &r)
+	if !r.ToAffine().Equal(g2) {
+		t.Fatal("aliased add does not match")
+	}
+}
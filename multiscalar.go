@@ -0,0 +1,145 @@
+package secp256k1
+
+import "math/bits"
+
+// below this many points MultiScalarMult uses Strauss' method,
+// above it Pippenger's bucket method is faster
+const pippengerThreshold = 64
+
+// MultiScalarMult computes sum(scalars[i] * points[i]). Small inputs use
+// Strauss' method (interleaved wNAF like DoubleScalarMultBase) and large
+// ones use Pippenger's bucket method. In both cases every scalar is first
+// split with the endomorphism. It is not constant time so it must not be
+// used with secret scalars. It panics if the slices have different lengths.
+func MultiScalarMult(scalars []*Scalar, points []*Point) *Point {
+	if len(scalars) != len(points) {
+		panic("secp256k1: number of scalars and points do not match")
+	}
+
+	if len(points) < pippengerThreshold {
+		return strauss(scalars, points)
+	}
+	return pippenger(scalars, points)
+}
+
+// strauss evaluates all the products in a single double-and-add loop
+// using the width-5 NAFs of the split scalars
+func strauss(scalars []*Scalar, points []*Point) *Point {
+	type term struct {
+		naf1, naf2     [wnafMaxLen]int8
+		table1, table2 [1 << (wnafWindow - 2)]JacobianPoint
+	}
+
+	terms := make([]term, 0, len(points))
+	length := 0
+	for i := range points {
+		if points[i].InfinityPoint || scalars[i].IsZero() {
+			continue
+		}
+
+		var t term
+		var l int
+		t.naf1, t.naf2, l = splitWNAF(scalars[i], wnafWindow)
+		length = max(length, l)
+
+		var q JacobianPoint
+		q.SetAffine(points[i])
+		t.table1, t.table2 = oddMultiplesEndo(&q)
+		terms = append(terms, t)
+	}
+
+	r := new(JacobianPoint).SetInfinity()
+	for i := length - 1; i >= 0; i-- {
+		r.Double(r)
+		for j := range terms {
+			r.addNAFDigit(&terms[j].table1, terms[j].naf1[i])
+			r.addNAFDigit(&terms[j].table2, terms[j].naf2[i])
+		}
+	}
+
+	return r.ToAffine()
+}
+
+// pippenger splits the scalars in windows of c bits. For each window
+// every point is added to the bucket of its digit and the buckets are
+// then combined with a running sum, sum(d * bucket[d]), so each window
+// costs about n + 2^(c+1) additions instead of a multiplication per point
+func pippenger(scalars []*Scalar, points []*Point) *Point {
+	// split every scalar so there are twice as many points but the
+	// scalars are only 128 bits. Negative halves negate their point
+	var halves []Scalar
+	var affine []Point
+	for i := range points {
+		if points[i].InfinityPoint || scalars[i].IsZero() {
+			continue
+		}
+
+		k1, k2 := splitScalar(scalars[i])
+		p1 := *points[i]
+		p2 := *points[i]
+		p2.X.Mult(&p2.X, &endoBeta)
+		if k1.isHigh() == 1 {
+			k1.Negate(&k1)
+			p1.Neg(&p1)
+		}
+		if k2.isHigh() == 1 {
+			k2.Negate(&k2)
+			p2.Neg(&p2)
+		}
+		halves = append(halves, k1, k2)
+		affine = append(affine, p1, p2)
+	}
+
+	c := pippengerWindow(len(affine))
+	windows := (128 + c - 1) / c
+	buckets := make([]JacobianPoint, 1<<c-1)
+
+	r := new(JacobianPoint).SetInfinity()
+	for w := windows - 1; w >= 0; w-- {
+		for i := 0; i < c; i++ {
+			r.Double(r)
+		}
+
+		for i := range buckets {
+			buckets[i].SetInfinity()
+		}
+		for i := range affine {
+			if d := scalarBits(&halves[i], uint(w*c), uint(c)); d != 0 {
+				buckets[d-1].AddMixed(&buckets[d-1], &affine[i])
+			}
+		}
+
+		// sum(d * bucket[d]) = bucket[max] + (bucket[max] + bucket[max-1]) + ...
+		var sum, acc JacobianPoint
+		sum.SetInfinity()
+		acc.SetInfinity()
+		for i := len(buckets) - 1; i >= 0; i-- {
+			sum.Add(&sum, &buckets[i])
+			acc.Add(&acc, &sum)
+		}
+		r.Add(r, &acc)
+	}
+
+	return r.ToAffine()
+}
+
+// pippengerWindow picks a window size of roughly log2(n) bits which
+// balances the additions into buckets against combining them
+func pippengerWindow(n int) int {
+	c := bits.Len(uint(n)) - 2
+	return min(max(c, 2), 16)
+}
+
+// scalarBits returns count bits of k starting at bit offset
+func scalarBits(k *Scalar, offset, count uint) int {
+	if offset >= 256 {
+		return 0
+	}
+	limb := offset / 64
+	shift := offset % 64
+	v := k.n[limb] >> shift
+	if shift+count > 64 && limb < 3 {
+		v |= k.n[limb+1] << (64 - shift)
+	}
+	return int(v & (1<<count - 1))
+}
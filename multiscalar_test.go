@@ -0,0 +1,77 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+func multiScalarTestInput(t *testing.T, n int) ([]*Scalar, []*Point) {
+	values := scalarTestValues(t)
+	scalars := make([]*Scalar, n)
+	points := make([]*Point, n)
+	for i := 0; i < n; i++ {
+		scalars[i], _ = NewScalar(values[i%len(values)])
+		k, _ := NewScalar(values[(i*7+3)%len(values)])
+		points[i] = BaseScalarMult(k)
+	}
+	return scalars, points
+}
+
+func naiveMultiScalarMult(scalars []*Scalar, points []*Point) *Point {
+	r := &Point{InfinityPoint: true}
+	for i := range points {
+		r = new(Point).Add(r, ScalarMult(scalars[i], points[i]))
+	}
+	return r
+}
+
+func TestMultiScalarMult(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5, 20, pippengerThreshold + 10} {
+		scalars, points := multiScalarTestInput(t, n)
+		expected := naiveMultiScalarMult(scalars, points)
+
+		if !MultiScalarMult(scalars, points).Equal(expected) {
+			t.Fatalf("MultiScalarMult does not match naive sum for n = %v", n)
+		}
+		if !strauss(scalars, points).Equal(expected) {
+			t.Fatalf("strauss does not match naive sum for n = %v", n)
+		}
+		if !pippenger(scalars, points).Equal(expected) {
+			t.Fatalf("pippenger does not match naive sum for n = %v", n)
+		}
+	}
+}
+
+func TestMultiScalarMultEdgeCases(t *testing.T) {
+	scalars, points := multiScalarTestInput(t, 6)
+
+	// zero scalar, point at infinity, and a pair that cancels out
+	scalars[0] = new(Scalar)
+	points[1] = &Point{InfinityPoint: true}
+	scalars[3].Set(scalars[2])
+	points[3] = new(Point).Neg(points[2])
+
+	expected := naiveMultiScalarMult(scalars, points)
+	if !strauss(scalars, points).Equal(expected) {
+		t.Fatal("strauss does not match naive sum")
+	}
+	if !pippenger(scalars, points).Equal(expected) {
+		t.Fatal("pippenger does not match naive sum")
+	}
+
+	// everything cancels out
+	k, _ := NewScalar(big.NewInt(42))
+	cancel := []*Point{Curve.G, new(Point).Neg(Curve.G)}
+	if !pippenger([]*Scalar{k, k}, cancel).InfinityPoint {
+		t.Fatal("expected point at infinity")
+	}
+}
+
+func TestMultiScalarMultLengthMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	MultiScalarMult([]*Scalar{new(Scalar)}, nil)
+}
@@ -0,0 +1,74 @@
+package secp256k1
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+const PrivKeyBytesLen = 32
+
+var (
+	ErrPrivKeyInvalidLength = errors.New("invalid private key length")
+	ErrPrivKeyZero          = errors.New("private key is zero")
+	ErrPrivKeyOverflow      = errors.New("private key is not less than n")
+)
+
+// PrivateKeyFromBytes parses a 32-byte big-endian private key. The value
+// must be in [1, n), it is never reduced
+func PrivateKeyFromBytes(b []byte) (*PrivateKey, error) {
+	if len(b) != PrivKeyBytesLen {
+		return nil, ErrPrivKeyInvalidLength
+	}
+
+	var buf [32]byte
+	copy(buf[:], b)
+	defer clear(buf[:])
+
+	scalar := new(Scalar)
+	if scalar.SetBytes(&buf) {
+		scalar.Zero()
+		return nil, ErrPrivKeyOverflow
+	}
+	if scalar.IsZero() {
+		return nil, ErrPrivKeyZero
+	}
+	return newPrivateKey(scalar), nil
+}
+
+// Serialize returns the 32-byte big-endian encoding of the private key
+func (pk *PrivateKey) Serialize() [32]byte {
+	return pk.SecretKey.Bytes()
+}
+
+// Zero wipes the secret key. The key must not be used afterwards
+func (pk *PrivateKey) Zero() {
+	pk.SecretKey.Zero()
+}
+
+// String describes the key by its public key. The secret is never
+// included, use RevealSecret to get it
+func (pk *PrivateKey) String() string {
+	if pk == nil {
+		return "<nil>"
+	}
+	// a zero value key has no public key
+	if pk.PublicKey == nil || pk.PublicKey.Point == nil {
+		return "PrivateKey{PublicKey: <nil>, SecretKey: REDACTED}"
+	}
+	return fmt.Sprintf("PrivateKey{PublicKey: %x, SecretKey: REDACTED}", pk.PublicKey.SerializeCompressed())
+}
+
+// Format makes every fmt verb, including %v, %+v, %#v and %x, print the
+// redacted String so the secret cannot end up in logs by accident
+func (pk *PrivateKey) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, pk.String())
+}
+
+// RevealSecret returns the secret key as hex. It is the only way to
+// print the secret and should be used with care
+func (pk *PrivateKey) RevealSecret() string {
+	b := pk.Serialize()
+	defer clear(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,124 @@
+package secp256k1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPrivateKeyFromBytes(t *testing.T) {
+	nMinusOne := new(Scalar).Negate(new(Scalar).SetInt(1)).Bytes()
+
+	tests := []struct {
+		name string
+		key  []byte
+		err  error
+	}{
+		{"one", append(make([]byte, 31), 1), nil},
+		{"n-1", nMinusOne[:], nil},
+		{"zero", make([]byte, 32), ErrPrivKeyZero},
+		{"n", Curve.N.Bytes(), ErrPrivKeyOverflow},
+		{"all ones", bytes.Repeat([]byte{0xff}, 32), ErrPrivKeyOverflow},
+		{"short", make([]byte, 31), ErrPrivKeyInvalidLength},
+		{"long", append(nMinusOne[:], 0), ErrPrivKeyInvalidLength},
+	}
+
+	for _, test := range tests {
+		key, err := PrivateKeyFromBytes(test.key)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+		if err != nil {
+			continue
+		}
+
+		if serialized := key.Serialize(); !bytes.Equal(serialized[:], test.key) {
+			t.Fatalf("%v: expected '%x' but got '%x'", test.name, test.key, serialized)
+		}
+		if !key.PublicKey.Equal(BaseScalarMult(key.SecretKey)) {
+			t.Fatalf("%v: public key does not match", test.name)
+		}
+	}
+}
+
+func TestNewPrivateKeyZero(t *testing.T) {
+	if _, err := NewPrivateKey(new(Scalar)); !errors.Is(err, ErrPrivKeyZero) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPrivKeyZero, err)
+	}
+
+	// the key does not share the scalar with the caller
+	s := new(Scalar).SetInt(5)
+	key, err := NewPrivateKey(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.SetInt(6)
+	if !key.SecretKey.Equal(new(Scalar).SetInt(5)) {
+		t.Fatal("modifying the scalar changed the key")
+	}
+}
+
+func TestPrivateKeyRedacted(t *testing.T) {
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := key.RevealSecret()
+	b := key.Serialize()
+	if secret != fmt.Sprintf("%x", b) {
+		t.Fatalf("expected '%x' but got '%v'", b, secret)
+	}
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%x", "%X", "%q"} {
+		out := fmt.Sprintf(format, key)
+		if strings.Contains(strings.ToLower(out), secret) {
+			t.Fatalf("%v: secret key leaked in '%v'", format, out)
+		}
+		if !strings.Contains(out, "REDACTED") {
+			t.Fatalf("%v: expected redacted output but got '%v'", format, out)
+		}
+	}
+
+	// keys inside other values are redacted too
+	out := fmt.Sprintf("%+v", struct{ Key *PrivateKey }{key})
+	if strings.Contains(out, secret) {
+		t.Fatalf("secret key leaked in '%v'", out)
+	}
+
+	// and so is the scalar on its own
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%x", "%d"} {
+		for _, v := range []any{key.SecretKey, *key.SecretKey, struct{ S Scalar }{*key.SecretKey}} {
+			out := fmt.Sprintf(format, v)
+			if strings.Contains(strings.ToLower(out), secret) || strings.Contains(out, key.SecretKey.BigInt().String()) {
+				t.Fatalf("%v: secret key leaked in '%v'", format, out)
+			}
+			if !strings.Contains(out, "REDACTED") {
+				t.Fatalf("%v: expected redacted output but got '%v'", format, out)
+			}
+		}
+	}
+
+	// a zero value key has no public key and can still be printed
+	out = fmt.Sprintf("%v", &PrivateKey{})
+	if !strings.Contains(out, "REDACTED") {
+		t.Fatalf("expected redacted output but got '%v'", out)
+	}
+}
+
+func TestPrivateKeyZero(t *testing.T) {
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := key.PublicKey.Copy()
+
+	key.Zero()
+	if !key.SecretKey.IsZero() {
+		t.Fatal("expected the secret key to be zero")
+	}
+	if !key.PublicKey.Equal(pub) {
+		t.Fatal("public key should not change")
+	}
+}
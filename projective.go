@@ -0,0 +1,173 @@
+package secp256k1
+
+// ProjectivePoint is a point in homogeneous projective coordinates,
+// representing the affine point (X/Z, Y/Z). The point at infinity is
+// (0:1:0), or any (0:Y:0).
+//
+// Points in these coordinates are added with the complete formulas from
+// Renes, Costello and Batina, "Complete addition formulas for prime order
+// elliptic curves" (https://eprint.iacr.org/2015/1060) for a = 0. They give
+// the right result for every pair of inputs, including the point at
+// infinity, doubling and adding a point to its negation, without any
+// branches, so they are used wherever the inputs are secret.
+type ProjectivePoint struct {
+	X FieldElement
+	Y FieldElement
+	Z FieldElement
+}
+
+// 3*b, used by the complete formulas
+var curveB3 = FieldElement{n: [4]uint64{21, 0, 0, 0}}
+
+func (p *ProjectivePoint) Set(q *ProjectivePoint) *ProjectivePoint {
+	*p = *q
+	return p
+}
+
+func (p *ProjectivePoint) SetInfinity() *ProjectivePoint {
+	p.X.SetInt(0)
+	p.Y.SetInt(1)
+	p.Z.SetInt(0)
+	return p
+}
+
+// SetAffine sets p to the affine point a with Z = 1
+func (p *ProjectivePoint) SetAffine(a *Point) *ProjectivePoint {
+	if a.InfinityPoint {
+		return p.SetInfinity()
+	}
+	p.X.Set(&a.X)
+	p.Y.Set(&a.Y)
+	p.Z.SetInt(1)
+	return p
+}
+
+func (p *ProjectivePoint) IsInfinity() bool {
+	return p.Z.IsZero()
+}
+
+// ToAffine converts p back to affine coordinates
+// x = X/Z, y = Y/Z
+func (p *ProjectivePoint) ToAffine() *Point {
+	if p.IsInfinity() {
+		return &Point{InfinityPoint: true}
+	}
+
+	zinv := new(FieldElement).Inverse(&p.Z)
+	r := new(Point)
+	r.X.Mult(&p.X, zinv)
+	r.Y.Mult(&p.Y, zinv)
+	return r
+}
+
+// NormalizeBatch converts many points to affine coordinates sharing a
+// single field inversion for all of them (see BatchInvert). Points at
+// infinity are returned as the affine point at infinity.
+func NormalizeBatch(points []*ProjectivePoint) []*Point {
+	zinv := make([]FieldElement, len(points))
+	elems := make([]*FieldElement, len(points))
+	for i := range points {
+		zinv[i].Set(&points[i].Z)
+		elems[i] = &zinv[i]
+	}
+	BatchInvert(elems)
+
+	res := make([]*Point, len(points))
+	for i, p := range points {
+		if p.IsInfinity() {
+			res[i] = &Point{InfinityPoint: true}
+			continue
+		}
+		r := new(Point)
+		r.X.Mult(&p.X, &zinv[i])
+		r.Y.Mult(&p.Y, &zinv[i])
+		res[i] = r
+	}
+	return res
+}
+
+// Add sets p to p1 + p2 for any p1 and p2 in constant time.
+// Algorithm 7 from the paper
+func (p *ProjectivePoint) Add(p1, p2 *ProjectivePoint) *ProjectivePoint {
+	var t0, t1, t2, t3, t4, x3, y3, z3 FieldElement
+
+	t0.Mult(&p1.X, &p2.X)
+	t1.Mult(&p1.Y, &p2.Y)
+	t2.Mult(&p1.Z, &p2.Z)
+	t3.Add(&p1.X, &p1.Y)
+	t4.Add(&p2.X, &p2.Y)
+	t3.Mult(&t3, &t4)
+	t4.Add(&t0, &t1)
+	t3.Sub(&t3, &t4)
+	t4.Add(&p1.Y, &p1.Z)
+	x3.Add(&p2.Y, &p2.Z)
+	t4.Mult(&t4, &x3)
+	x3.Add(&t1, &t2)
+	t4.Sub(&t4, &x3)
+	x3.Add(&p1.X, &p1.Z)
+	y3.Add(&p2.X, &p2.Z)
+	x3.Mult(&x3, &y3)
+	y3.Add(&t0, &t2)
+	y3.Sub(&x3, &y3)
+	x3.Add(&t0, &t0)
+	t0.Add(&x3, &t0)
+	t2.Mult(&curveB3, &t2)
+	z3.Add(&t1, &t2)
+	t1.Sub(&t1, &t2)
+	y3.Mult(&curveB3, &y3)
+	x3.Mult(&t4, &y3)
+	t2.Mult(&t3, &t1)
+	x3.Sub(&t2, &x3)
+	y3.Mult(&y3, &t0)
+	t1.Mult(&t1, &z3)
+	y3.Add(&t1, &y3)
+	t0.Mult(&t0, &t3)
+	z3.Mult(&z3, &t4)
+	z3.Add(&z3, &t0)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
+
+// Double sets p to 2q for any q in constant time.
+// Algorithm 9 from the paper
+func (p *ProjectivePoint) Double(q *ProjectivePoint) *ProjectivePoint {
+	var t0, t1, t2, x3, y3, z3 FieldElement
+
+	t0.Square(&q.Y)
+	z3.Add(&t0, &t0)
+	z3.Add(&z3, &z3)
+	z3.Add(&z3, &z3)
+	t1.Mult(&q.Y, &q.Z)
+	t2.Square(&q.Z)
+	t2.Mult(&curveB3, &t2)
+	x3.Mult(&t2, &z3)
+	y3.Add(&t0, &t2)
+	z3.Mult(&t1, &z3)
+	t1.Add(&t2, &t2)
+	t2.Add(&t1, &t2)
+	t0.Sub(&t0, &t2)
+	y3.Mult(&t0, &y3)
+	y3.Add(&x3, &y3)
+	t1.Mult(&q.X, &q.Y)
+	x3.Mult(&t0, &t1)
+	x3.Add(&x3, &x3)
+
+	p.X, p.Y, p.Z = x3, y3, z3
+	return p
+}
+
+// cmove sets p to q if flag is 1 in constant time
+func (p *ProjectivePoint) cmove(q *ProjectivePoint, flag int) {
+	p.X.CMove(&q.X, flag)
+	p.Y.CMove(&q.Y, flag)
+	p.Z.CMove(&q.Z, flag)
+}
+
+// endomorphism returns lambda*p = (beta*X, Y, Z)
+func (p *ProjectivePoint) endomorphism(q *ProjectivePoint) *ProjectivePoint {
+	p.X.Mult(&q.X, &endoBeta)
+	p.Y.Set(&q.Y)
+	p.Z.Set(&q.Z)
+	return p
+}
@@ -0,0 +1,120 @@
+package secp256k1
+
+import "testing"
+
+func TestProjectiveAddAndDouble(t *testing.T) {
+	g := Curve.G
+	g2 := pointFromHex(t, "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+		"1ae168fea63dc339a3c58419466ceaeef7f632653266d0e1236431a950cfe52a")
+	g3 := pointFromHex(t, "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+		"388f7b0f632de8140fe337e62a37f3566500a99934c2231b6cb9fd7584b8e672")
+	infinity := &Point{InfinityPoint: true}
+
+	var pg, pg2, negG, inf ProjectivePoint
+	pg.SetAffine(g)
+	pg2.SetAffine(g2)
+	negG.SetAffine(new(Point).Neg(g))
+	inf.SetInfinity()
+
+	// (0:-1:0) is also the point at infinity
+	var negInf ProjectivePoint
+	negInf.SetInfinity()
+	negInf.Y.Negate(&negInf.Y)
+
+	// a representation of G with Z != 1
+	var scaled ProjectivePoint
+	var z FieldElement
+	z.SetInt(7)
+	scaled.X.Mult(&pg.X, &z)
+	scaled.Y.Mult(&pg.Y, &z)
+	scaled.Z.Set(&z)
+
+	tests := []struct {
+		name     string
+		got      *Point
+		expected *Point
+	}{
+		{"double", new(ProjectivePoint).Double(&pg).ToAffine(), g2},
+		{"add", new(ProjectivePoint).Add(&pg2, &pg).ToAffine(), g3},
+		{"add same point", new(ProjectivePoint).Add(&pg, &pg).ToAffine(), g2},
+		{"add scaled", new(ProjectivePoint).Add(&scaled, &pg2).ToAffine(), g3},
+		{"add scaled same point", new(ProjectivePoint).Add(&scaled, &pg).ToAffine(), g2},
+		{"add negation", new(ProjectivePoint).Add(&pg, &negG).ToAffine(), infinity},
+		{"add infinity", new(ProjectivePoint).Add(&pg, &inf).ToAffine(), g},
+		{"infinity add", new(ProjectivePoint).Add(&inf, &pg).ToAffine(), g},
+		{"infinity add infinity", new(ProjectivePoint).Add(&inf, &negInf).ToAffine(), infinity},
+		{"add negated infinity", new(ProjectivePoint).Add(&pg2, &negInf).ToAffine(), g2},
+		{"double infinity", new(ProjectivePoint).Double(&inf).ToAffine(), infinity},
+		{"double scaled", new(ProjectivePoint).Double(&scaled).ToAffine(), g2},
+	}
+
+	for _, test := range tests {
+		if !test.got.Equal(test.expected) {
+			t.Fatalf("%v: points do not match", test.name)
+		}
+	}
+
+	// receiver aliasing an argument
+	var r ProjectivePoint
+	r.SetAffine(g)
+	r.Add(&r, &r)
+	if !r.ToAffine().Equal(g2) {
+		t.Fatal("aliased add does not match")
+	}
+}
+
+func TestPointAddComplete(t *testing.T) {
+	g := Curve.G
+	g2 := ScalarMultVartime(new(Scalar).SetInt(2), g)
+	g3 := ScalarMultVartime(new(Scalar).SetInt(3), g)
+	infinity := &Point{InfinityPoint: true}
+
+	tests := []struct {
+		name     string
+		p1, p2   *Point
+		expected *Point
+	}{
+		{"distinct points", g2, g, g3},
+		{"same point", g, g, g2},
+		{"negation", g, new(Point).Neg(g), infinity},
+		{"infinity right", g, infinity, g},
+		{"infinity left", infinity, g, g},
+		{"both infinity", infinity, infinity, infinity},
+	}
+
+	for _, test := range tests {
+		if got := new(Point).Add(test.p1, test.p2); !got.Equal(test.expected) {
+			t.Fatalf("%v: points do not match", test.name)
+		}
+	}
+}
+
+func TestNormalizeBatch(t *testing.T) {
+	var points []*ProjectivePoint
+	var expected []*Point
+	for i := uint64(0); i < 10; i++ {
+		p := ScalarMultVartime(new(Scalar).SetInt(i), Curve.G)
+
+		// scale by an arbitrary z so the points are not already normalized
+		var q ProjectivePoint
+		var z FieldElement
+		z.SetInt(i*i + 3)
+		q.SetAffine(p)
+		q.X.Mult(&q.X, &z)
+		q.Y.Mult(&q.Y, &z)
+		q.Z.Mult(&q.Z, &z)
+
+		points = append(points, &q)
+		expected = append(expected, p)
+	}
+
+	got := NormalizeBatch(points)
+	for i := range expected {
+		if !got[i].Equal(expected[i]) {
+			t.Fatalf("point %v does not match", i)
+		}
+		if !got[i].Equal(points[i].ToAffine()) {
+			t.Fatalf("point %v does not match ToAffine", i)
+		}
+	}
+}
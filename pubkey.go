@@ -0,0 +1,130 @@
+package secp256k1
+
+import "errors"
+
+const (
+	PubKeyBytesLenCompressed   = 33
+	PubKeyBytesLenUncompressed = 65
+
+	// SEC1 format prefixes
+	pubKeyCompressedEven byte = 0x02
+	pubKeyCompressedOdd  byte = 0x03
+	pubKeyUncompressed   byte = 0x04
+	pubKeyHybridEven     byte = 0x06
+	pubKeyHybridOdd      byte = 0x07
+)
+
+var (
+	ErrPubKeyInvalidLength = errors.New("invalid public key length")
+	ErrPubKeyInvalidFormat = errors.New("invalid public key format")
+	ErrPubKeyOverflow      = errors.New("public key coordinate is not less than p")
+)
+
+// SerializeCompressed returns the 33-byte SEC1 encoding of the public key:
+// 0x02 or 0x03 depending on whether y is even or odd followed by x
+func (pk *PublicKey) SerializeCompressed() []byte {
+	if pk.InfinityPoint {
+		return []byte{0x00}
+	}
+
+	b := make([]byte, PubKeyBytesLenCompressed)
+	b[0] = pubKeyCompressedEven
+	if pk.Y.IsOdd() {
+		b[0] = pubKeyCompressedOdd
+	}
+	x := pk.X.Bytes()
+	copy(b[1:], x[:])
+	return b
+}
+
+// SerializeUncompressed returns the 65-byte SEC1 encoding of the
+// public key: 0x04 followed by x and y
+func (pk *PublicKey) SerializeUncompressed() []byte {
+	if pk.InfinityPoint {
+		return []byte{0x00}
+	}
+
+	b := make([]byte, PubKeyBytesLenUncompressed)
+	b[0] = pubKeyUncompressed
+	x := pk.X.Bytes()
+	y := pk.Y.Bytes()
+	copy(b[1:33], x[:])
+	copy(b[33:], y[:])
+	return b
+}
+
+// ParsePubKey parses a public key in the SEC1 compressed (33 bytes) or
+// uncompressed (65 bytes) format and checks that it is on the curve.
+// The hybrid format (0x06/0x07 prefix) is rejected, use
+// ParsePubKeyAllowHybrid to accept it.
+func ParsePubKey(b []byte) (*PublicKey, error) {
+	return parsePubKey(b, false)
+}
+
+// ParsePubKeyAllowHybrid is like ParsePubKey but also accepts the
+// uncompressed hybrid format where the prefix holds the parity of y
+func ParsePubKeyAllowHybrid(b []byte) (*PublicKey, error) {
+	return parsePubKey(b, true)
+}
+
+func parsePubKey(b []byte, allowHybrid bool) (*PublicKey, error) {
+	switch len(b) {
+	case PubKeyBytesLenCompressed:
+		if b[0] != pubKeyCompressedEven && b[0] != pubKeyCompressedOdd {
+			return nil, ErrPubKeyInvalidFormat
+		}
+
+		var xBytes [32]byte
+		copy(xBytes[:], b[1:])
+		x := new(FieldElement)
+		if x.SetBytes(&xBytes) {
+			return nil, ErrPubKeyOverflow
+		}
+
+		// y^2 = x^3 + 7
+		y := new(FieldElement)
+		y.Square(x).Mult(y, x).Add(y, Curve.B)
+		if !y.Sqrt(y) {
+			return nil, ErrPointNotOnCurve
+		}
+		if y.IsOdd() != (b[0] == pubKeyCompressedOdd) {
+			y.Negate(y)
+		}
+
+		return &PublicKey{Point: &Point{X: *x, Y: *y, InfinityPoint: false}}, nil
+
+	case PubKeyBytesLenUncompressed:
+		switch b[0] {
+		case pubKeyUncompressed:
+		case pubKeyHybridEven, pubKeyHybridOdd:
+			if !allowHybrid {
+				return nil, ErrPubKeyInvalidFormat
+			}
+		default:
+			return nil, ErrPubKeyInvalidFormat
+		}
+
+		var xBytes, yBytes [32]byte
+		copy(xBytes[:], b[1:33])
+		copy(yBytes[:], b[33:])
+		x := new(FieldElement)
+		y := new(FieldElement)
+		if x.SetBytes(&xBytes) || y.SetBytes(&yBytes) {
+			return nil, ErrPubKeyOverflow
+		}
+
+		// in the hybrid format the prefix has to match the parity of y
+		if b[0] != pubKeyUncompressed && y.IsOdd() != (b[0] == pubKeyHybridOdd) {
+			return nil, ErrPubKeyInvalidFormat
+		}
+
+		point, err := NewPoint(x, y)
+		if err != nil {
+			return nil, err
+		}
+		return &PublicKey{Point: point}, nil
+
+	default:
+		return nil, ErrPubKeyInvalidLength
+	}
+}
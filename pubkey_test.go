@@ -0,0 +1,117 @@
+package secp256k1
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const (
+	gCompressed   = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	gUncompressed = "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+		"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"
+)
+
+func TestSerializePubKey(t *testing.T) {
+	g := &PublicKey{Point: Curve.G}
+	if got := hex.EncodeToString(g.SerializeCompressed()); got != gCompressed {
+		t.Fatalf("expected '%v' but got '%v'", gCompressed, got)
+	}
+	if got := hex.EncodeToString(g.SerializeUncompressed()); got != gUncompressed {
+		t.Fatalf("expected '%v' but got '%v'", gUncompressed, got)
+	}
+
+	// -G has an odd y
+	negG := &PublicKey{Point: new(Point).Neg(Curve.G)}
+	if got := negG.SerializeCompressed(); got[0] != 0x03 {
+		t.Fatalf("expected prefix 03 but got '%x'", got[0])
+	}
+
+	for _, kInt := range scalarTestValues(t)[1:] {
+		k, _ := NewScalar(kInt)
+		key, err := NewPrivateKey(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pub := key.PublicKey
+
+		for _, b := range [][]byte{pub.SerializeCompressed(), pub.SerializeUncompressed()} {
+			parsed, err := ParsePubKey(b)
+			if err != nil {
+				t.Fatalf("error parsing '%x': %v", b, err)
+			}
+			if !parsed.Point.Equal(pub.Point) {
+				t.Fatalf("parsed key does not match for '%x'", b)
+			}
+		}
+	}
+}
+
+func TestParsePubKey(t *testing.T) {
+	hybridEven := "06" + gUncompressed[2:]
+	hybridOdd := "07" + gUncompressed[2:]
+
+	tests := []struct {
+		name        string
+		key         string
+		allowHybrid bool
+		err         error
+	}{
+		{"compressed", gCompressed, false, nil},
+		{"uncompressed", gUncompressed, false, nil},
+		{"hybrid rejected", hybridEven, false, ErrPubKeyInvalidFormat},
+		{"hybrid allowed", hybridEven, true, nil},
+		{"hybrid wrong parity", hybridOdd, true, ErrPubKeyInvalidFormat},
+		{"empty", "", false, ErrPubKeyInvalidLength},
+		{"infinity", "00", false, ErrPubKeyInvalidLength},
+		{"wrong length", gCompressed[:64], false, ErrPubKeyInvalidLength},
+		{"wrong compressed prefix", "04" + gCompressed[2:], false, ErrPubKeyInvalidFormat},
+		{"wrong uncompressed prefix", "05" + gUncompressed[2:], false, ErrPubKeyInvalidFormat},
+		{
+			"x not on curve",
+			"020000000000000000000000000000000000000000000000000000000000000000",
+			false,
+			ErrPointNotOnCurve,
+		},
+		{
+			"x overflows",
+			"02fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30",
+			false,
+			ErrPubKeyOverflow,
+		},
+		{
+			"y not on curve",
+			gUncompressed[:128] + "b9",
+			false,
+			ErrPointNotOnCurve,
+		},
+	}
+
+	for _, test := range tests {
+		b, _ := hex.DecodeString(test.key)
+
+		var err error
+		var pub *PublicKey
+		if test.allowHybrid {
+			pub, err = ParsePubKeyAllowHybrid(b)
+		} else {
+			pub, err = ParsePubKey(b)
+		}
+
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+		if err == nil && !bytes.Equal(pub.SerializeCompressed(), mustDecodeHex(t, gCompressed)) {
+			t.Fatalf("%v: parsed key is not G", test.name)
+		}
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
@@ -0,0 +1,227 @@
+package secp256k1
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+var (
+	ErrScalarNegative = errors.New("scalar is negative")
+	ErrScalarOverflow = errors.New("scalar is not less than n")
+)
+
+// Scalar is an integer modulo the group order n. Like FieldElement it
+// is stored as 4 64-bit limbs in little-endian order and is always
+// fully reduced in [0, n).
+type Scalar struct {
+	n [4]uint64
+}
+
+var scalarOrder = [4]uint64{0xBFD25E8CD0364141, 0xBAAEDCE6AF48A03B, 0xFFFFFFFFFFFFFFFE, 0xFFFFFFFFFFFFFFFF}
+
+// 2^256 - n
+var scalarC = [3]uint64{0x402DA1732FC9BEBF, 0x4551231950B75FC4, 1}
+
+// n - 2, exponent used for inversion
+var scalarOrderMinusTwo = [4]uint64{0xBFD25E8CD036413F, 0xBAAEDCE6AF48A03B, 0xFFFFFFFFFFFFFFFE, 0xFFFFFFFFFFFFFFFF}
+
+// (n-1)/2
+var scalarHalfOrder = [4]uint64{0xDFE92F46681B20A0, 0x5D576E7357A4501D, 0xFFFFFFFFFFFFFFFF, 0x7FFFFFFFFFFFFFFF}
+
+var scalarOrderInt = limbsToBigInt(&scalarOrder)
+
+// NewScalar returns number as a scalar. It must be in [0, n), use
+// ScalarFromBytesReduced when reducing modulo n is intended
+func NewScalar(number *big.Int) (*Scalar, error) {
+	if number.Sign() < 0 {
+		return nil, ErrScalarNegative
+	}
+	if number.Cmp(scalarOrderInt) >= 0 {
+		return nil, ErrScalarOverflow
+	}
+
+	var b [32]byte
+	number.FillBytes(b[:])
+	s := new(Scalar)
+	s.SetBytes(&b)
+	return s, nil
+}
+
+// ScalarFromBytesReduced interprets b as a big-endian integer of any
+// length and reduces it modulo n. Unlike SetByteSlice every byte of b
+// is used
+func ScalarFromBytesReduced(b []byte) *Scalar {
+	v := new(big.Int).SetBytes(b)
+	v.Mod(v, scalarOrderInt)
+
+	var buf [32]byte
+	v.FillBytes(buf[:])
+	s := new(Scalar)
+	s.SetBytes(&buf)
+	return s
+}
+
+func (s *Scalar) Set(x *Scalar) *Scalar {
+	s.n = x.n
+	return s
+}
+
+func (s *Scalar) SetInt(v uint64) *Scalar {
+	s.n = [4]uint64{v, 0, 0, 0}
+	return s
+}
+
+// SetBytes interprets b as a big-endian integer and reduces it modulo n.
+// It returns true if the value was >= n and had to be reduced
+func (s *Scalar) SetBytes(b *[32]byte) bool {
+	n := bytesToLimbs(b)
+	r, overflow := subIfGreaterOrEqual(n, &scalarOrder)
+	s.n = r
+	return overflow
+}
+
+// SetByteSlice is like SetBytes but takes a slice of any length. Slices
+// shorter than 32 bytes are zero padded on the left and only the first
+// 32 bytes of longer ones are used, as is done to convert hashes to
+// integers in ECDSA
+func (s *Scalar) SetByteSlice(b []byte) bool {
+	var buf [32]byte
+	if len(b) > 32 {
+		b = b[:32]
+	}
+	copy(buf[32-len(b):], b)
+	return s.SetBytes(&buf)
+}
+
+// Bytes returns the 32-byte big-endian encoding of the scalar
+func (s *Scalar) Bytes() [32]byte {
+	return limbsToBytes(&s.n)
+}
+
+func (s *Scalar) BigInt() *big.Int {
+	return limbsToBigInt(&s.n)
+}
+
+// Zero wipes the value of s, setting it to 0. Use it on secret
+// scalars once they are no longer needed
+func (s *Scalar) Zero() {
+	s.n = [4]uint64{}
+}
+
+// String never includes the value since scalars are often secret keys
+// or nonces. Use Bytes or BigInt to get it
+func (s Scalar) String() string {
+	return "Scalar{REDACTED}"
+}
+
+// Format prints the redacted String for every fmt verb. It has a value
+// receiver so a Scalar stored by value in a struct is redacted as well
+func (s Scalar) Format(f fmt.State, verb rune) {
+	fmt.Fprint(f, s.String())
+}
+
+func (s *Scalar) IsZero() bool {
+	return (s.n[0] | s.n[1] | s.n[2] | s.n[3]) == 0
+}
+
+func (s *Scalar) Equal(x *Scalar) bool {
+	d := (s.n[0] ^ x.n[0]) | (s.n[1] ^ x.n[1]) | (s.n[2] ^ x.n[2]) | (s.n[3] ^ x.n[3])
+	return d == 0
+}
+
+func (s *Scalar) Add(x, y *Scalar) *Scalar {
+	s.n = addMod(&x.n, &y.n, &scalarOrder)
+	return s
+}
+
+func (s *Scalar) Sub(x, y *Scalar) *Scalar {
+	s.n = subMod(&x.n, &y.n, &scalarOrder)
+	return s
+}
+
+func (s *Scalar) Negate(x *Scalar) *Scalar {
+	var zero [4]uint64
+	s.n = subMod(&zero, &x.n, &scalarOrder)
+	return s
+}
+
+// CondNegate negates s if flag is 1 in constant time
+func (s *Scalar) CondNegate(flag int) *Scalar {
+	var neg Scalar
+	neg.Negate(s)
+	mask := -uint64(flag)
+	for i := range s.n {
+		s.n[i] ^= mask & (s.n[i] ^ neg.n[i])
+	}
+	return s
+}
+
+// isHigh returns 1 if s > (n-1)/2 and 0 otherwise in constant time
+func (s *Scalar) isHigh() int {
+	var borrow uint64
+	_, borrow = bits.Sub64(scalarHalfOrder[0], s.n[0], 0)
+	_, borrow = bits.Sub64(scalarHalfOrder[1], s.n[1], borrow)
+	_, borrow = bits.Sub64(scalarHalfOrder[2], s.n[2], borrow)
+	_, borrow = bits.Sub64(scalarHalfOrder[3], s.n[3], borrow)
+	return int(borrow)
+}
+
+// IsHigh reports whether s is in the upper half of the range,
+// s > (n-1)/2
+func (s *Scalar) IsHigh() bool {
+	return s.isHigh() == 1
+}
+
+func (s *Scalar) Mul(x, y *Scalar) *Scalar {
+	t := mul256(&x.n, &y.n)
+	s.n = scalarReduce(&t)
+	return s
+}
+
+// Inverse sets s to x^-1 mod n computed as x^(n-2). The exponent is
+// fixed so this runs in constant time. The inverse of 0 is 0.
+func (s *Scalar) Inverse(x *Scalar) *Scalar {
+	base := *x
+	r := Scalar{n: [4]uint64{1, 0, 0, 0}}
+	for i := 255; i >= 0; i-- {
+		r.Mul(&r, &r)
+		if (scalarOrderMinusTwo[i/64]>>(i%64))&1 == 1 {
+			r.Mul(&r, &base)
+		}
+	}
+	s.n = r.n
+	return s
+}
+
+// scalarReduce reduces a 512-bit product modulo n
+func scalarReduce(t *[8]uint64) [4]uint64 {
+	// 2^256 = c (mod n) with c ~129 bits, so repeatedly replace
+	// lo + hi*2^256 with lo + hi*c. Four rounds always bring the
+	// value below 2^256 (512 -> 386 -> 260 -> 257 -> 256 bits)
+	for round := 0; round < 4; round++ {
+		var r [8]uint64
+		copy(r[:4], t[:4])
+		for i := 0; i < 4; i++ {
+			var carry uint64
+			for j := 0; j < 3; j++ {
+				hi, lo := bits.Mul64(t[4+i], scalarC[j])
+				var c uint64
+				lo, c = bits.Add64(lo, r[i+j], 0)
+				hi += c
+				lo, c = bits.Add64(lo, carry, 0)
+				hi += c
+				r[i+j] = lo
+				carry = hi
+			}
+			for k := i + 3; k < 8; k++ {
+				r[k], carry = bits.Add64(r[k], carry, 0)
+			}
+		}
+		*t = r
+	}
+
+	r, _ := subIfGreaterOrEqual([4]uint64{t[0], t[1], t[2], t[3]}, &scalarOrder)
+	return r
+}
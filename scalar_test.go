@@ -0,0 +1,182 @@
+package secp256k1
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func scalarTestValues(t *testing.T) []*big.Int {
+	nMinusOne := new(big.Int).Sub(scalarOrderInt, big.NewInt(1))
+	halfOrder := new(big.Int).Rsh(scalarOrderInt, 1)
+	values := []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(2), nMinusOne, halfOrder}
+	for i := 0; i < 50; i++ {
+		r, err := rand.Int(rand.Reader, scalarOrderInt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, r)
+	}
+	return values
+}
+
+func TestScalarArithmetic(t *testing.T) {
+	values := scalarTestValues(t)
+	n := scalarOrderInt
+
+	for _, a := range values {
+		for _, b := range values {
+			x, _ := NewScalar(a)
+			y, _ := NewScalar(b)
+
+			sum := new(big.Int).Add(a, b)
+			if got := new(Scalar).Add(x, y).BigInt(); got.Cmp(sum.Mod(sum, n)) != 0 {
+				t.Fatalf("%x + %x: expected '%x' but got '%x'", a, b, sum, got)
+			}
+
+			diff := new(big.Int).Sub(a, b)
+			if got := new(Scalar).Sub(x, y).BigInt(); got.Cmp(diff.Mod(diff, n)) != 0 {
+				t.Fatalf("%x - %x: expected '%x' but got '%x'", a, b, diff, got)
+			}
+
+			prod := new(big.Int).Mul(a, b)
+			if got := new(Scalar).Mul(x, y).BigInt(); got.Cmp(prod.Mod(prod, n)) != 0 {
+				t.Fatalf("%x * %x: expected '%x' but got '%x'", a, b, prod, got)
+			}
+		}
+	}
+}
+
+func TestScalarInverseAndNegate(t *testing.T) {
+	for _, a := range scalarTestValues(t) {
+		x, _ := NewScalar(a)
+
+		expected := new(big.Int).ModInverse(a, scalarOrderInt)
+		if expected == nil {
+			expected = new(big.Int)
+		}
+		if got := new(Scalar).Inverse(x).BigInt(); got.Cmp(expected) != 0 {
+			t.Fatalf("inverse of %x: expected '%x' but got '%x'", a, expected, got)
+		}
+
+		neg := new(Scalar).Negate(x)
+		if !new(Scalar).Add(neg, x).IsZero() {
+			t.Fatalf("%x + -%x is not zero", a, a)
+		}
+	}
+}
+
+func TestScalarSetBytes(t *testing.T) {
+	var b [32]byte
+	for i := range b {
+		b[i] = 0xff
+	}
+
+	s := new(Scalar)
+	if overflow := s.SetBytes(&b); !overflow {
+		t.Fatal("expected overflow setting 2^256 - 1")
+	}
+	expected := new(big.Int).SetBytes(b[:])
+	expected.Mod(expected, scalarOrderInt)
+	if s.BigInt().Cmp(expected) != 0 {
+		t.Fatalf("expected '%x' but got '%x'", expected, s.BigInt())
+	}
+
+	nBytes := limbsToBytes(&scalarOrder)
+	if overflow := s.SetBytes(&nBytes); !overflow || !s.IsZero() {
+		t.Fatal("expected n to overflow to zero")
+	}
+
+	// short slices are left padded and long ones truncated
+	s.SetByteSlice([]byte{0x01, 0x02})
+	if s.BigInt().Cmp(big.NewInt(0x0102)) != 0 {
+		t.Fatalf("expected '0102' but got '%x'", s.BigInt())
+	}
+	long := make([]byte, 40)
+	long[31] = 0x05
+	long[39] = 0xff
+	s.SetByteSlice(long)
+	if s.BigInt().Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("expected '05' but got '%x'", s.BigInt())
+	}
+
+	for _, a := range scalarTestValues(t) {
+		x, _ := NewScalar(a)
+		b := x.Bytes()
+		if new(big.Int).SetBytes(b[:]).Cmp(a) != 0 {
+			t.Fatalf("expected '%x' but got '%x'", a, b)
+		}
+	}
+}
+
+func TestNewScalarRange(t *testing.T) {
+	nMinusOne := new(big.Int).Sub(scalarOrderInt, big.NewInt(1))
+	nPlusOne := new(big.Int).Add(scalarOrderInt, big.NewInt(1))
+
+	tests := []struct {
+		value *big.Int
+		err   error
+	}{
+		{big.NewInt(0), nil},
+		{nMinusOne, nil},
+		{big.NewInt(-1), ErrScalarNegative},
+		{scalarOrderInt, ErrScalarOverflow},
+		{nPlusOne, ErrScalarOverflow},
+	}
+
+	for _, test := range tests {
+		s, err := NewScalar(test.value)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("%x: expected '%v' but got '%v'", test.value, test.err, err)
+		}
+		if err == nil && s.BigInt().Cmp(test.value) != 0 {
+			t.Fatalf("expected '%x' but got '%x'", test.value, s.BigInt())
+		}
+	}
+}
+
+func TestScalarFromBytesReduced(t *testing.T) {
+	inputs := [][]byte{
+		nil,
+		{0x01},
+		scalarOrderInt.Bytes(),
+		bytes.Repeat([]byte{0xff}, 32),
+		bytes.Repeat([]byte{0xab}, 64),
+		bytes.Repeat([]byte{0x5c}, 100),
+	}
+
+	for _, b := range inputs {
+		expected := new(big.Int).SetBytes(b)
+		expected.Mod(expected, scalarOrderInt)
+		if got := ScalarFromBytesReduced(b).BigInt(); got.Cmp(expected) != 0 {
+			t.Fatalf("%x: expected '%x' but got '%x'", b, expected, got)
+		}
+	}
+}
+
+func TestScalarIsHigh(t *testing.T) {
+	half := new(big.Int).Rsh(Curve.N, 1)
+
+	tests := []struct {
+		value    *big.Int
+		expected bool
+	}{
+		{big.NewInt(0), false},
+		{big.NewInt(1), false},
+		{half, false},
+		{new(big.Int).Add(half, big.NewInt(1)), true},
+		{new(big.Int).Sub(Curve.N, big.NewInt(1)), true},
+	}
+
+	for _, test := range tests {
+		s, err := NewScalar(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.IsHigh() != test.expected {
+			t.Fatalf("expected '%v' but got '%v' for %x", test.expected, s.IsHigh(), test.value)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package secp256k1
+
+import (
+	"crypto/subtle"
+	"sync"
+)
+
+// number of signed 4-bit digits processed for each half of the split
+// scalar in ScalarMult. The halves are below 2^128, 32 digits cover
+// them and the extra one holds the carry left over from the recoding
+const constWindows = 33
+
+// width of the windowed NAF used for variable time multiplication
+const wnafWindow = 5
+
+// ScalarMult computes k*p in constant time so it is safe to use with
+// secret scalars (key generation, signing, ECDH).
+//
+// k is split with the endomorphism into two ~128-bit halves so that
+// k*p = k1*p + k2*(lambda*p), and both halves are evaluated together
+// using a fixed window of 4 bits with signed digits in [-8, 8]. Every
+// window does the same 4 doublings and two complete additions in
+// projective coordinates no matter the value of the digits, the table
+// lookups read every entry and negative digits are handled with a
+// conditional negation.
+func ScalarMult(k *Scalar, p *Point) *Point {
+	// a half that is "negative" (above n/2) is negated so that it fits
+	// in 128 bits and its point is negated instead
+	k1, k2 := splitScalar(k)
+	neg1 := k1.isHigh()
+	neg2 := k2.isHigh()
+	k1.CondNegate(neg1)
+	k2.CondNegate(neg2)
+
+	// tables of 0*p..8*p and 0*lambda*p..8*lambda*p with the signs applied
+	var table1, table2 [9]ProjectivePoint
+	table1[0].SetInfinity()
+	table1[1].SetAffine(p)
+	table1[1].Y.CondNegate(neg1)
+	for i := 2; i < len(table1); i++ {
+		table1[i].Add(&table1[i-1], &table1[1])
+	}
+	for i := range table2 {
+		table2[i].endomorphism(&table1[i])
+		table2[i].Y.CondNegate(neg1 ^ neg2)
+	}
+
+	digits1 := signedDigits(&k1)
+	digits2 := signedDigits(&k2)
+
+	var r, t ProjectivePoint
+	r.SetInfinity()
+	for i := constWindows - 1; i >= 0; i-- {
+		r.Double(&r)
+		r.Double(&r)
+		r.Double(&r)
+		r.Double(&r)
+
+		t.lookup(&table1, digits1[i])
+		r.Add(&r, &t)
+		t.lookup(&table2, digits2[i])
+		r.Add(&r, &t)
+	}
+
+	return r.ToAffine()
+}
+
+// signedDigits recodes k into base 16 digits in [-8, 8] such that
+// k = sum(digits[i] * 16^i). Digits >= 8 are replaced by d - 16 with a
+// carry into the next digit. This is done without branches.
+func signedDigits(k *Scalar) [65]int {
+	var digits [65]int
+	carry := 0
+	for i := 0; i < 64; i++ {
+		d := int((k.n[i/16]>>(4*(i%16)))&0xf) + carry
+		// carry is 1 if d >= 8
+		carry = (d + 8) >> 4
+		digits[i] = d - carry<<4
+	}
+	digits[64] = carry
+	return digits
+}
+
+// lookup sets p to d*q from a table of 0*q..8*q in constant time
+func (p *ProjectivePoint) lookup(table *[9]ProjectivePoint, d int) {
+	// sign is 1 if d is negative
+	sign := int(uint64(d) >> 63)
+	abs := (d ^ -sign) + sign
+
+	p.SetInfinity()
+	for i := range table {
+		p.cmove(&table[i], subtle.ConstantTimeEq(int32(i), int32(abs)))
+	}
+	p.Y.CondNegate(sign)
+}
+
+// ScalarMultVartime computes k*p using the endomorphism to split k into
+// two halves that are evaluated together using their width-5 NAFs.
+// Its running time depends on k so it must only be used when k is
+// public, like when verifying signatures
+func ScalarMultVartime(k *Scalar, p *Point) *Point {
+	naf1, naf2, length := splitWNAF(k, wnafWindow)
+
+	var q JacobianPoint
+	q.SetAffine(p)
+	table1, table2 := oddMultiplesEndo(&q)
+
+	r := new(JacobianPoint).SetInfinity()
+	for i := length - 1; i >= 0; i-- {
+		r.Double(r)
+		r.addNAFDigit(&table1, naf1[i])
+		r.addNAFDigit(&table2, naf2[i])
+	}
+
+	return r.ToAffine()
+}
+
+// DoubleScalarMultBase computes a*G + b*p using Shamir's trick: both
+// products are evaluated in the same loop so they share the doublings.
+// Each scalar is split with the endomorphism and the four halves are
+// interleaved using their NAFs, with a wider window for G since its odd
+// multiples are precomputed. It is not constant time and is meant for
+// signature verification where a, b and p are public.
+func DoubleScalarMultBase(a, b *Scalar, p *Point) *Point {
+	nafA1, nafA2, lenA := splitWNAF(a, wnafWindowG)
+	nafB1, nafB2, lenB := splitWNAF(b, wnafWindow)
+
+	gTable, gLambdaTable := baseOddMultiples()
+
+	var q JacobianPoint
+	q.SetAffine(p)
+	pTable, pLambdaTable := oddMultiplesEndo(&q)
+
+	r := new(JacobianPoint).SetInfinity()
+	for i := max(lenA, lenB) - 1; i >= 0; i-- {
+		r.Double(r)
+		r.addNAFDigitAffine(gTable, nafA1[i])
+		r.addNAFDigitAffine(gLambdaTable, nafA2[i])
+		r.addNAFDigit(&pTable, nafB1[i])
+		r.addNAFDigit(&pLambdaTable, nafB2[i])
+	}
+
+	return r.ToAffine()
+}
+
+// splitWNAF splits k with the endomorphism and returns the width-w NAFs
+// of both halves and the length of the longest one. Halves above n/2 are
+// negated so they fit in 128 bits and their digits are negated back
+func splitWNAF(k *Scalar, w uint) (naf1, naf2 [wnafMaxLen]int8, length int) {
+	k1, k2 := splitScalar(k)
+
+	neg1 := k1.isHigh() == 1
+	if neg1 {
+		k1.Negate(&k1)
+	}
+	neg2 := k2.isHigh() == 1
+	if neg2 {
+		k2.Negate(&k2)
+	}
+
+	naf1, len1 := wnaf(&k1, w)
+	naf2, len2 := wnaf(&k2, w)
+	for i := 0; i < len1 && neg1; i++ {
+		naf1[i] = -naf1[i]
+	}
+	for i := 0; i < len2 && neg2; i++ {
+		naf2[i] = -naf2[i]
+	}
+
+	return naf1, naf2, max(len1, len2)
+}
+
+// window for the precomputed odd multiples of G used in DoubleScalarMultBase
+const wnafWindowG = 8
+
+var (
+	gOddMultiples       [1 << (wnafWindowG - 2)]Point
+	gLambdaOddMultiples [1 << (wnafWindowG - 2)]Point
+	gOddMultiplesOnce   sync.Once
+)
+
+// baseOddMultiples returns G, 3G, 5G, ... and the same multiples of
+// lambda*G in affine coordinates. They are computed the first time
+// they are needed
+func baseOddMultiples() (*[1 << (wnafWindowG - 2)]Point, *[1 << (wnafWindowG - 2)]Point) {
+	gOddMultiplesOnce.Do(func() {
+		var g, g2, t JacobianPoint
+		g.SetAffine(Curve.G)
+		g2.Double(&g)
+		t.Set(&g)
+		for i := range gOddMultiples {
+			gOddMultiples[i] = *t.ToAffine()
+			gLambdaOddMultiples[i] = gOddMultiples[i]
+			gLambdaOddMultiples[i].X.Mult(&gOddMultiples[i].X, &endoBeta)
+			t.Add(&t, &g2)
+		}
+	})
+	return &gOddMultiples, &gLambdaOddMultiples
+}
+
+// oddMultiplesEndo returns the odd multiples of p and of lambda*p
+func oddMultiplesEndo(p *JacobianPoint) (table, lambdaTable [1 << (wnafWindow - 2)]JacobianPoint) {
+	table = oddMultiples(p)
+	for i := range lambdaTable {
+		lambdaTable[i].endomorphism(&table[i])
+	}
+	return table, lambdaTable
+}
+
+// oddMultiples returns p, 3p, 5p, ..., (2^(w-1) - 1)p for the NAF window
+func oddMultiples(p *JacobianPoint) [1 << (wnafWindow - 2)]JacobianPoint {
+	var table [1 << (wnafWindow - 2)]JacobianPoint
+	var p2 JacobianPoint
+	p2.Double(p)
+
+	table[0].Set(p)
+	for i := 1; i < len(table); i++ {
+		table[i].Add(&table[i-1], &p2)
+	}
+	return table
+}
+
+// addNAFDigit adds d*q to p where table holds the odd multiples of q
+func (p *JacobianPoint) addNAFDigit(table *[1 << (wnafWindow - 2)]JacobianPoint, d int8) {
+	if d > 0 {
+		p.Add(p, &table[d/2])
+	} else if d < 0 {
+		var neg JacobianPoint
+		neg.Set(&table[-d/2])
+		neg.Y.Negate(&neg.Y)
+		p.Add(p, &neg)
+	}
+}
+
+// addNAFDigitAffine is like addNAFDigit for a table in affine coordinates
+func (p *JacobianPoint) addNAFDigitAffine(table *[1 << (wnafWindowG - 2)]Point, d int8) {
+	if d > 0 {
+		p.AddMixed(p, &table[d/2])
+	} else if d < 0 {
+		var neg Point
+		neg.Neg(&table[-d/2])
+		p.AddMixed(p, &neg)
+	}
+}
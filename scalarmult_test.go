@@ -0,0 +1,111 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScalarMult(t *testing.T) {
+	nMinusOne := new(big.Int).Sub(Curve.N, big.NewInt(1))
+
+	tests := []struct {
+		k        *big.Int
+		expected *Point
+	}{
+		{big.NewInt(0), &Point{InfinityPoint: true}},
+		{big.NewInt(1), Curve.G},
+		{
+			big.NewInt(2),
+			pointFromHex(t, "c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5",
+				"1ae168fea63dc339a3c58419466ceaeef7f632653266d0e1236431a950cfe52a"),
+		},
+		{
+			big.NewInt(3),
+			pointFromHex(t, "f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9",
+				"388f7b0f632de8140fe337e62a37f3566500a99934c2231b6cb9fd7584b8e672"),
+		},
+		{nMinusOne, new(Point).Neg(Curve.G)},
+	}
+
+	for _, test := range tests {
+		k, err := NewScalar(test.k)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := BaseScalarMult(k); !got.Equal(test.expected) {
+			t.Fatalf("%x*G does not match", test.k)
+		}
+		if got := BaseScalarMultVartime(k); !got.Equal(test.expected) {
+			t.Fatalf("vartime %x*G does not match", test.k)
+		}
+	}
+
+	// (a*b)*G == a*(b*G)
+	a, _ := NewScalar(big.NewInt(0x1234567))
+	b, _ := NewScalar(new(big.Int).Rsh(Curve.N, 3))
+	ab := new(Scalar).Mul(a, b)
+	if !BaseScalarMult(ab).Equal(ScalarMult(a, BaseScalarMult(b))) {
+		t.Fatal("(a*b)*G != a*(b*G)")
+	}
+}
+
+func TestScalarMultConstMatchesVartime(t *testing.T) {
+	p := BaseScalarMult(new(Scalar).SetInt(0xdeadbeef))
+
+	for _, kInt := range scalarTestValues(t) {
+		k, _ := NewScalar(kInt)
+		if !ScalarMult(k, p).Equal(ScalarMultVartime(k, p)) {
+			t.Fatalf("constant time and vartime results differ for k = %x", kInt)
+		}
+	}
+
+	k, _ := NewScalar(big.NewInt(5))
+	if !ScalarMult(k, &Point{InfinityPoint: true}).InfinityPoint {
+		t.Fatal("expected infinity multiplying the point at infinity")
+	}
+}
+
+func TestSignedDigits(t *testing.T) {
+	for _, kInt := range scalarTestValues(t) {
+		k, _ := NewScalar(kInt)
+		digits := signedDigits(k)
+
+		sum := new(big.Int)
+		for i := len(digits) - 1; i >= 0; i-- {
+			if digits[i] < -8 || digits[i] > 8 {
+				t.Fatalf("digit %v out of range", digits[i])
+			}
+			sum.Lsh(sum, 4).Add(sum, big.NewInt(int64(digits[i])))
+		}
+		if sum.Cmp(kInt) != 0 {
+			t.Fatalf("expected '%x' but got '%x'", kInt, sum)
+		}
+	}
+}
+
+func TestDoubleScalarMultBase(t *testing.T) {
+	p := BaseScalarMult(new(Scalar).SetInt(0xc0ffee))
+	values := scalarTestValues(t)
+
+	for i := range values {
+		a, _ := NewScalar(values[i])
+		b, _ := NewScalar(values[len(values)-1-i])
+
+		expected := new(Point).Add(BaseScalarMult(a), ScalarMult(b, p))
+		if !DoubleScalarMultBase(a, b, p).Equal(expected) {
+			t.Fatalf("a*G + b*P does not match for a = %x, b = %x", values[i], values[len(values)-1-i])
+		}
+	}
+
+	// a*G + a*(-G) is the point at infinity
+	a, _ := NewScalar(values[10])
+	if !DoubleScalarMultBase(a, a, new(Point).Neg(Curve.G)).InfinityPoint {
+		t.Fatal("expected point at infinity")
+	}
+
+	// b*P with P at infinity
+	if !DoubleScalarMultBase(a, a, &Point{InfinityPoint: true}).Equal(BaseScalarMult(a)) {
+		t.Fatal("a*G + b*infinity != a*G")
+	}
+}
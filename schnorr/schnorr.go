@@ -2,140 +2,151 @@ package schnorr
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"errors"
-	"math/big"
+	"io"
 
 	"github.com/elnosh/secp256k1"
 )
 
+var (
+	ErrSigInvalidLength = errors.New("signature must be 64 bytes")
+	ErrSigROverflow     = errors.New("signature r is not less than p")
+	ErrSigSOverflow     = errors.New("signature s is not less than n")
+)
+
 type Signature struct {
-	r *big.Int
-	s *big.Int
+	r secp256k1.FieldElement
+	s secp256k1.Scalar
 }
 
+// ParseSignature parses a 64-byte BIP-340 signature. It fails if r is not
+// less than p or s is not less than n, those signatures are invalid and
+// must not be verified as their reduced values
+func ParseSignature(b []byte) (*Signature, error) {
+	if len(b) != 64 {
+		return nil, ErrSigInvalidLength
+	}
+
+	var r, s [32]byte
+	copy(r[:], b[:32])
+	copy(s[:], b[32:])
+
+	sig := new(Signature)
+	if sig.r.SetBytes(&r) {
+		return nil, ErrSigROverflow
+	}
+	if sig.s.SetBytes(&s) {
+		return nil, ErrSigSOverflow
+	}
+	return sig, nil
+}
+
+// Sign signs hash with auxiliary randomness from crypto/rand
 func Sign(key *secp256k1.PrivateKey, hash []byte) (*Signature, error) {
-	a, err := secp256k1.GeneratePrivateKey()
-	if err != nil {
+	return SignWithRand(rand.Reader, key, hash)
+}
+
+// SignWithRand is like Sign but reads the 32 bytes of auxiliary
+// randomness from random. The nonce is derived from the key and message
+// so the signature stays secure even if random is not.
+func SignWithRand(random io.Reader, key *secp256k1.PrivateKey, hash []byte) (*Signature, error) {
+	var aux [32]byte
+	if _, err := io.ReadFull(random, aux[:]); err != nil {
 		return nil, err
 	}
-	return sign(key, hash, a)
+	return sign(key, hash, &aux)
 }
 
-func sign(key *secp256k1.PrivateKey, hash []byte, nonce *secp256k1.PrivateKey) (*Signature, error) {
-	sk := key.Copy()
-
-	y := new(big.Int).Set(sk.PublicKey.Y.Value)
-	mod := new(big.Int).Mod(y, big.NewInt(2))
+func sign(key *secp256k1.PrivateKey, hash []byte, aux *[32]byte) (*Signature, error) {
+	d := new(secp256k1.Scalar).Set(key.SecretKey)
+	defer d.Zero()
 	// negate secret key if y-coordinate is not even
-	if mod.Cmp(big.NewInt(0)) != 0 {
-		d := new(big.Int).Sub(secp256k1.Curve.N, sk.SecretKey.N)
-		dScalar, err := secp256k1.NewScalar(d)
-		if err != nil {
-			return nil, err
-		}
-		sk = secp256k1.NewPrivateKey(dScalar)
+	if !hasEvenY(key.PublicKey.Point) {
+		d.Negate(d)
 	}
 
 	// xor sk and hash_bip340/aux_tagged(a)
-	nonceBuf := make([]byte, 32)
-	auxHash := TaggedHash("BIP0340/aux", nonce.SecretKey.N.FillBytes(nonceBuf))
+	auxHash := TaggedHash("BIP0340/aux", aux[:])
 
-	t := new(big.Int)
-	t.Xor(sk.SecretKey.N, new(big.Int).SetBytes(auxHash))
+	dBytes := d.Bytes()
+	defer clear(dBytes[:])
+	t := make([]byte, 32)
+	defer clear(t)
+	for i := range t {
+		t[i] = dBytes[i] ^ auxHash[i]
+	}
 
-	tbuf := make([]byte, 32)
-	pubkeybuf := make([]byte, 32)
-	randBytes := bytes.Join([][]byte{t.FillBytes(tbuf), sk.PublicKey.X.Value.FillBytes(pubkeybuf), hash}, nil)
+	pubkeyBytes := key.PublicKey.X.Bytes()
+	randBytes := bytes.Join([][]byte{t, pubkeyBytes[:], hash}, nil)
+	defer clear(randBytes)
 	rand := TaggedHash("BIP0340/nonce", randBytes)
+	defer clear(rand)
 
-	kint := new(big.Int).SetBytes(rand)
-	kint.Mod(kint, secp256k1.Curve.N)
-	mod = new(big.Int).Mod(kint, secp256k1.Curve.N)
-	if mod.Cmp(big.NewInt(0)) == 0 {
+	k := new(secp256k1.Scalar)
+	defer k.Zero()
+	k.SetByteSlice(rand)
+	if k.IsZero() {
 		return nil, errors.New("could not generate signature")
 	}
 
-	kScalar, err := secp256k1.NewScalar(kint)
-	if err != nil {
-		return nil, err
-	}
-
-	k := secp256k1.NewPrivateKey(kScalar)
-	y = new(big.Int).Set(k.PublicKey.Y.Value)
-	mod = new(big.Int).Mod(y, big.NewInt(2))
-	if k.PublicKey.InfinityPoint || mod.Cmp(big.NewInt(0)) != 0 {
-		kint := new(big.Int).Sub(secp256k1.Curve.N, kint)
-		kScalar, err := secp256k1.NewScalar(kint)
-		if err != nil {
-			return nil, err
-		}
-		k = secp256k1.NewPrivateKey(kScalar)
+	R := secp256k1.BaseScalarMult(k)
+	if !hasEvenY(R) {
+		k.Negate(k)
 	}
 
-	Rbuf := make([]byte, 32)
-	ebytes := bytes.Join([][]byte{k.PublicKey.X.Value.FillBytes(Rbuf), pubkeybuf, hash}, nil)
-	challengeHash := TaggedHash("BIP0340/challenge", ebytes)
-	e := new(big.Int).SetBytes(challengeHash)
-	e.Mod(e, secp256k1.Curve.N)
+	Rbytes := R.X.Bytes()
+	e := challenge(Rbytes[:], pubkeyBytes[:], hash)
 
-	s := new(big.Int).Set(k.SecretKey.N)
-	s.Add(s, e.Mul(e, sk.SecretKey.N)).Mod(s, secp256k1.Curve.N)
+	// s = k + ed mod n
+	s := new(secp256k1.Scalar).Mul(e, d)
+	s.Add(s, k)
 
-	return &Signature{r: k.PublicKey.X.Value, s: s}, nil
+	return &Signature{r: R.X, s: *s}, nil
 }
 
 func (s *Signature) Verify(pubkey *secp256k1.PublicKey, hash []byte) bool {
-	y := new(big.Int).Set(pubkey.Y.Value)
-	mod := new(big.Int).Mod(y, big.NewInt(2))
-	// fail if y-coordinate of public key is not even
-	if mod.Cmp(big.NewInt(0)) != 0 {
+	if pubkey.Validate() != nil {
 		return false
 	}
 
-	if s.r.Cmp(secp256k1.Curve.P) >= 0 {
-		return false
-	}
-	if s.s.Cmp(secp256k1.Curve.N) >= 0 {
+	// public keys are x-only in BIP-340, the key for an x-coordinate is
+	// the point with an even y-coordinate as returned by ParsePublicKey
+	if !hasEvenY(pubkey.Point) {
 		return false
 	}
 
-	rbuf := make([]byte, 32)
-	pubkeybuf := make([]byte, 32)
-	ebytes := bytes.Join([][]byte{s.r.FillBytes(rbuf), pubkey.X.Value.FillBytes(pubkeybuf), hash}, nil)
-	challengeHash := TaggedHash("BIP0340/challenge", ebytes)
-	e := new(big.Int).SetBytes(challengeHash)
-	e.Mod(e, secp256k1.Curve.N)
+	rBytes := s.r.Bytes()
+	pubkeyBytes := pubkey.X.Bytes()
+	e := challenge(rBytes[:], pubkeyBytes[:], hash)
 
-	sScalar, err := secp256k1.NewScalar(s.s)
-	if err != nil {
-		return false
-	}
-
-	eScalar, err := secp256k1.NewScalar(e)
-	if err != nil {
-		return false
-	}
-
-	R := secp256k1.BaseScalarMult(sScalar)
-	eP := secp256k1.ScalarMult(eScalar, pubkey.Point)
-	R.Add(R, eP.Inverse())
+	// R = sG - eP
+	e.Negate(e)
+	R := secp256k1.DoubleScalarMultBase(&s.s, e, pubkey.Point)
 
 	if R.InfinityPoint {
 		return false
 	}
 
-	RY := new(big.Int).Set(R.Y.Value)
-	mod = new(big.Int).Mod(RY, big.NewInt(2))
-	if mod.Cmp(big.NewInt(0)) != 0 {
+	if !hasEvenY(R) {
 		return false
 	}
 
-	if R.X.Value.Cmp(s.r) != 0 {
-		return false
-	}
+	return R.X.Equal(&s.r)
+}
+
+// e = int(hash_BIP0340/challenge(bytes(R) || bytes(P) || m)) mod n
+func challenge(r, pubkey, hash []byte) *secp256k1.Scalar {
+	ebytes := bytes.Join([][]byte{r, pubkey, hash}, nil)
+	challengeHash := TaggedHash("BIP0340/challenge", ebytes)
+	e := new(secp256k1.Scalar)
+	e.SetByteSlice(challengeHash)
+	return e
+}
 
-	return true
+func hasEvenY(p *secp256k1.Point) bool {
+	return !p.Y.IsOdd()
 }
 
 func TaggedHash(tag string, x []byte) []byte {
@@ -149,42 +160,27 @@ func TaggedHash(tag string, x []byte) []byte {
 
 // this does lift_x as explained in the bip
 func ParsePublicKey(pubkeyBytes []byte) (*secp256k1.PublicKey, error) {
-	xcoordinate := new(big.Int).SetBytes(pubkeyBytes)
-
-	if xcoordinate.Cmp(secp256k1.Curve.P) > 0 {
-		return nil, errors.New("x is over p")
+	if len(pubkeyBytes) != 32 {
+		return nil, errors.New("public key must be 32 bytes")
 	}
 
-	c := secp256k1.NewFieldElement(xcoordinate)
-	c.Pow(c, big.NewInt(3)).Add(c, secp256k1.Curve.B)
-
-	exponent := new(big.Int).Set(secp256k1.Curve.P)
-	exponent.Add(exponent, big.NewInt(1)).Div(exponent, big.NewInt(4)).Mod(exponent, secp256k1.Curve.P)
-
-	y := secp256k1.NewFieldElement(c.Value)
-	y.Pow(y, exponent)
-
-	ysquared := secp256k1.NewFieldElement(y.Value)
-	ysquared.Pow(ysquared, big.NewInt(2))
-
-	if !ysquared.Equal(c) {
-		return nil, errors.New("invalid public key")
+	var xBytes [32]byte
+	copy(xBytes[:], pubkeyBytes)
+	var x secp256k1.FieldElement
+	if x.SetBytes(&xBytes) {
+		return nil, errors.New("x is over p")
 	}
 
-	yint := new(big.Int).Set(y.Value)
-	mod := new(big.Int).Mod(yint, big.NewInt(2))
-	if mod.Cmp(big.NewInt(0)) != 0 {
-		ycoordinate := new(big.Int).Set(secp256k1.Curve.P)
-		ycoordinate.Sub(ycoordinate, yint)
-
-		y = secp256k1.NewFieldElement(ycoordinate)
+	// y^2 = x^3 + 7
+	var y secp256k1.FieldElement
+	y.Square(&x).Mult(&y, &x).Add(&y, secp256k1.Curve.B)
+	if !y.Sqrt(&y) {
+		return nil, secp256k1.ErrPointNotOnCurve
 	}
-
-	point := &secp256k1.Point{
-		X:             secp256k1.NewFieldElement(xcoordinate),
-		Y:             y,
-		InfinityPoint: false,
+	if y.IsOdd() {
+		y.Negate(&y)
 	}
 
-	return &secp256k1.PublicKey{point}, nil
+	point := &secp256k1.Point{X: x, Y: y, InfinityPoint: false}
+	return &secp256k1.PublicKey{Point: point}, nil
 }
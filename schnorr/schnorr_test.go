@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
-	"math/big"
+	"errors"
 	"strings"
 	"testing"
 
@@ -59,22 +59,26 @@ func TestSign(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		keyInt, _ := new(big.Int).SetString(test.signingKey, 16)
-		scalar, _ := secp256k1.NewScalar(keyInt)
-		sk := secp256k1.NewPrivateKey(scalar)
+		keyBytes, _ := hex.DecodeString(test.signingKey)
+		sk, err := secp256k1.PrivateKeyFromBytes(keyBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-		nonceInt, _ := new(big.Int).SetString(test.auxRand, 16)
-		nonce, _ := secp256k1.NewScalar(nonceInt)
-		nonceKey := secp256k1.NewPrivateKey(nonce)
+		var aux [32]byte
+		auxBytes, _ := hex.DecodeString(test.auxRand)
+		copy(aux[:], auxBytes)
 
 		message, _ := hex.DecodeString(test.message)
 
-		signature, err := sign(sk, message, nonceKey)
+		signature, err := sign(sk, message, &aux)
 		if err != nil {
 			t.Fatalf("error signing: %v", err)
 		}
 
-		signatureBytes := bytes.Join([][]byte{signature.r.Bytes(), signature.s.Bytes()}, nil)
+		r := signature.r.Bytes()
+		s := signature.s.Bytes()
+		signatureBytes := bytes.Join([][]byte{r[:], s[:]}, nil)
 		sigHex := strings.ToUpper(hex.EncodeToString(signatureBytes))
 
 		if sigHex != test.expectedSignature {
@@ -128,6 +132,20 @@ func TestVerify(t *testing.T) {
 			message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
 			expected:  false,
 		},
+		{
+			// r is equal to the field size
+			signature: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B",
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			expected:  false,
+		},
+		{
+			// s is equal to the curve order
+			signature: "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141",
+			publicKey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			message:   "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			expected:  false,
+		},
 	}
 
 	for _, test := range tests {
@@ -138,10 +156,13 @@ func TestVerify(t *testing.T) {
 		}
 
 		signatureBytes, _ := hex.DecodeString(test.signature)
-		r := new(big.Int).SetBytes(signatureBytes[:32])
-		s := new(big.Int).SetBytes(signatureBytes[32:])
-
-		signature := &Signature{r: r, s: s}
+		signature, err := ParseSignature(signatureBytes)
+		if err != nil {
+			if test.expected {
+				t.Fatalf("error parsing signature '%v': %v", test.signature, err)
+			}
+			continue
+		}
 
 		message, _ := hex.DecodeString(test.message)
 
@@ -153,6 +174,31 @@ func TestVerify(t *testing.T) {
 
 }
 
+func TestParseSignature(t *testing.T) {
+	r := "6CFF5C3BA86C69EA4B7376F31A9BCB4F74C1976089B2D9963DA2E5543E177769"
+	s := "69E89B4C5564D00349106B8497785DD7D1D713A8AE82B32FA79D5F7FC407D39B"
+	p := "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F"
+	n := "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141"
+
+	tests := []struct {
+		name      string
+		signature string
+		err       error
+	}{
+		{"valid", r + s, nil},
+		{"short", r + s[:62], ErrSigInvalidLength},
+		{"r is p", p + s, ErrSigROverflow},
+		{"s is n", r + n, ErrSigSOverflow},
+	}
+
+	for _, test := range tests {
+		b, _ := hex.DecodeString(test.signature)
+		if _, err := ParseSignature(b); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+	}
+}
+
 func TestSignAndVerify(t *testing.T) {
 	privateKey, err := secp256k1.GeneratePrivateKey()
 	if err != nil {
@@ -166,7 +212,63 @@ func TestSignAndVerify(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if !signature.Verify(privateKey.PublicKey, hash[:]) {
+	x := privateKey.PublicKey.X.Bytes()
+	pubkey, err := ParsePublicKey(x[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(pubkey, hash[:]) {
 		t.Fatal("invalid signature")
 	}
 }
+
+func TestVerifyOddY(t *testing.T) {
+	// the x-only key is the point with an even y, the point with the
+	// same x and an odd y is not accepted as the public key
+	pubkeyBytes, _ := hex.DecodeString("DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659")
+	pubkey, err := ParsePublicKey(pubkeyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	odd := &secp256k1.PublicKey{Point: new(secp256k1.Point).Neg(pubkey.Point)}
+
+	signatureBytes, _ := hex.DecodeString("6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A")
+	message, _ := hex.DecodeString("243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89")
+	signature, err := ParseSignature(signatureBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !signature.Verify(pubkey, message) {
+		t.Fatal("expected valid signature with the even y key")
+	}
+	if signature.Verify(odd, message) {
+		t.Fatal("expected odd y key to be rejected")
+	}
+}
+
+func TestSignWithRand(t *testing.T) {
+	// second BIP-340 vector with aux_rand read from the reader
+	keyBytes, _ := hex.DecodeString("B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF")
+	sk, err := secp256k1.PrivateKeyFromBytes(keyBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message, _ := hex.DecodeString("243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89")
+	aux, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000001")
+	expected := "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A"
+
+	signature, err := SignWithRand(bytes.NewReader(aux), sk, message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := signature.r.Bytes()
+	s := signature.s.Bytes()
+	if sigHex := strings.ToUpper(hex.EncodeToString(append(r[:], s[:]...))); sigHex != expected {
+		t.Fatalf("expected signature '%v' but got '%v'", expected, sigHex)
+	}
+
+	if _, err := SignWithRand(bytes.NewReader(aux[:16]), sk, message); err == nil {
+		t.Fatal("expected error with a short random source")
+	}
+}
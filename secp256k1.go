@@ -29,13 +29,13 @@ func init() {
 
 	x := new(big.Int)
 	x.SetString("0X79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798", 0)
-	gx := &FieldElement{Value: x}
+	gx := NewFieldElement(x)
 
 	y := new(big.Int)
 	y.SetString("0X483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8", 0)
-	gy := &FieldElement{Value: y}
+	gy := NewFieldElement(y)
 
-	g := &Point{X: gx, Y: gy, InfinityPoint: false}
+	g := &Point{X: *gx, Y: *gy, InfinityPoint: false}
 
 	Curve = &CurveParams{P: p, A: NewFieldElement(big.NewInt(0)), B: NewFieldElement(big.NewInt(7)), N: n, G: g}
 }
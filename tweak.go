@@ -0,0 +1,93 @@
+package secp256k1
+
+import "errors"
+
+var ErrNoPublicKeys = errors.New("no public keys to combine")
+
+// TweakAdd returns the private key d + tweak. It fails with
+// ErrPrivKeyZero if the result is zero
+func (pk *PrivateKey) TweakAdd(tweak *Scalar) (*PrivateKey, error) {
+	d := new(Scalar).Add(pk.SecretKey, tweak)
+	if d.IsZero() {
+		return nil, ErrPrivKeyZero
+	}
+	return newPrivateKey(d), nil
+}
+
+// TweakMul returns the private key d * tweak. It fails with
+// ErrPrivKeyZero if the tweak is zero
+func (pk *PrivateKey) TweakMul(tweak *Scalar) (*PrivateKey, error) {
+	d := new(Scalar).Mul(pk.SecretKey, tweak)
+	if d.IsZero() {
+		return nil, ErrPrivKeyZero
+	}
+	return newPrivateKey(d), nil
+}
+
+// Negate returns the private key -d, whose public key is -P
+func (pk *PrivateKey) Negate() *PrivateKey {
+	return &PrivateKey{
+		SecretKey: new(Scalar).Negate(pk.SecretKey),
+		PublicKey: pk.PublicKey.Negate(),
+	}
+}
+
+// TweakAdd returns the public key P + tweak*G, which matches
+// PrivateKey.TweakAdd. It fails with ErrPointAtInfinity if the result
+// is the point at infinity
+func (pk *PublicKey) TweakAdd(tweak *Scalar) (*PublicKey, error) {
+	if err := pk.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := new(Point).Add(pk.Point, BaseScalarMult(tweak))
+	if p.InfinityPoint {
+		return nil, ErrPointAtInfinity
+	}
+	return &PublicKey{Point: p}, nil
+}
+
+// TweakMul returns the public key tweak*P, which matches
+// PrivateKey.TweakMul. It fails with ErrPointAtInfinity if the tweak
+// is zero
+func (pk *PublicKey) TweakMul(tweak *Scalar) (*PublicKey, error) {
+	if err := pk.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := ScalarMult(tweak, pk.Point)
+	if p.InfinityPoint {
+		return nil, ErrPointAtInfinity
+	}
+	return &PublicKey{Point: p}, nil
+}
+
+// Negate returns the public key -P
+func (pk *PublicKey) Negate() *PublicKey {
+	return &PublicKey{Point: new(Point).Neg(pk.Point)}
+}
+
+// CombinePublicKeys returns the sum of the public keys. It fails if
+// there are no keys, any of them is invalid or they add up to the
+// point at infinity
+func CombinePublicKeys(keys ...*PublicKey) (*PublicKey, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoPublicKeys
+	}
+
+	var sum, q ProjectivePoint
+	sum.SetInfinity()
+	for _, key := range keys {
+		if err := key.Validate(); err != nil {
+			return nil, err
+		}
+		q.SetAffine(key.Point)
+		sum.Add(&sum, &q)
+	}
+
+	p := sum.ToAffine()
+	if p.InfinityPoint {
+		return nil, ErrPointAtInfinity
+	}
+	return &PublicKey{Point: p}, nil
+}
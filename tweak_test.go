@@ -0,0 +1,132 @@
+package secp256k1
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTweakAdd(t *testing.T) {
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kInt := range scalarTestValues(t) {
+		tweak, _ := NewScalar(kInt)
+
+		tweakedPriv, privErr := key.TweakAdd(tweak)
+		tweakedPub, pubErr := key.PublicKey.TweakAdd(tweak)
+		if privErr != nil || pubErr != nil {
+			t.Fatalf("unexpected errors '%v' and '%v'", privErr, pubErr)
+		}
+		if !tweakedPriv.PublicKey.Equal(tweakedPub.Point) {
+			t.Fatalf("tweaked keys do not match for %x", kInt)
+		}
+		if !tweakedPriv.SecretKey.Equal(new(Scalar).Add(key.SecretKey, tweak)) {
+			t.Fatalf("expected d + t for %x", kInt)
+		}
+	}
+
+	// tweaking by -d gives zero
+	minusD := new(Scalar).Negate(key.SecretKey)
+	if _, err := key.TweakAdd(minusD); !errors.Is(err, ErrPrivKeyZero) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPrivKeyZero, err)
+	}
+	if _, err := key.PublicKey.TweakAdd(minusD); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPointAtInfinity, err)
+	}
+}
+
+func TestTweakMul(t *testing.T) {
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kInt := range scalarTestValues(t)[1:] {
+		tweak, _ := NewScalar(kInt)
+
+		tweakedPriv, privErr := key.TweakMul(tweak)
+		tweakedPub, pubErr := key.PublicKey.TweakMul(tweak)
+		if privErr != nil || pubErr != nil {
+			t.Fatalf("unexpected errors '%v' and '%v'", privErr, pubErr)
+		}
+		if !tweakedPriv.PublicKey.Equal(tweakedPub.Point) {
+			t.Fatalf("tweaked keys do not match for %x", kInt)
+		}
+	}
+
+	zero := new(Scalar)
+	if _, err := key.TweakMul(zero); !errors.Is(err, ErrPrivKeyZero) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPrivKeyZero, err)
+	}
+	if _, err := key.PublicKey.TweakMul(zero); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPointAtInfinity, err)
+	}
+
+	invalid := &PublicKey{Point: &Point{InfinityPoint: true}}
+	if _, err := invalid.TweakMul(new(Scalar).SetInt(2)); !errors.Is(err, ErrPointAtInfinity) {
+		t.Fatalf("expected '%v' but got '%v'", ErrPointAtInfinity, err)
+	}
+}
+
+func TestNegateKeys(t *testing.T) {
+	key, err := GeneratePrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	neg := key.Negate()
+	if !neg.PublicKey.Equal(BaseScalarMult(neg.SecretKey)) {
+		t.Fatal("negated public key does not match")
+	}
+	if !neg.PublicKey.Equal(key.PublicKey.Negate().Point) {
+		t.Fatal("expected -P")
+	}
+	if !neg.Negate().SecretKey.Equal(key.SecretKey) {
+		t.Fatal("expected --d = d")
+	}
+}
+
+func TestCombinePublicKeys(t *testing.T) {
+	var keys []*PublicKey
+	sum := new(Scalar)
+	for i := 0; i < 5; i++ {
+		key, err := GeneratePrivateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key.PublicKey)
+		sum.Add(sum, key.SecretKey)
+	}
+
+	combined, err := CombinePublicKeys(keys...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !combined.Equal(BaseScalarMult(sum)) {
+		t.Fatal("combined key does not match the sum of the private keys")
+	}
+
+	single, err := CombinePublicKeys(keys[0])
+	if err != nil || !single.Equal(keys[0].Point) {
+		t.Fatalf("expected the same key but got '%v'", err)
+	}
+
+	tests := []struct {
+		name string
+		keys []*PublicKey
+		err  error
+	}{
+		{"no keys", nil, ErrNoPublicKeys},
+		{"cancel out", []*PublicKey{keys[0], keys[0].Negate()}, ErrPointAtInfinity},
+		{"infinity", []*PublicKey{keys[0], {Point: &Point{InfinityPoint: true}}}, ErrPointAtInfinity},
+		{"off curve", []*PublicKey{{Point: &Point{X: keys[0].Y, Y: keys[0].X}}}, ErrPointNotOnCurve},
+	}
+
+	for _, test := range tests {
+		if _, err := CombinePublicKeys(test.keys...); !errors.Is(err, test.err) {
+			t.Fatalf("%v: expected '%v' but got '%v'", test.name, test.err, err)
+		}
+	}
+}